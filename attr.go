@@ -0,0 +1,272 @@
+package Logger
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+)
+
+// maxBinaryBytes is the maximum number of bytes rendered by Hex and Base64.
+var maxBinaryBytes atomic.Int64
+
+func init() {
+	maxBinaryBytes.Store(64)
+}
+
+// Attr is a typed key-value pair attached to a log entry.
+// The value keeps its original type (string, int64, bool, float64 or time.Time).
+type Attr struct {
+	Key   string
+	Value interface{}
+}
+
+// String creates an Attr holding a string value.
+// Parameters:
+// - key: The attribute name.
+// - value: The string value.
+// Returns:
+// - An Attr with the given key and value.
+func String(key, value string) Attr {
+	return Attr{Key: key, Value: value}
+}
+
+// Int creates an Attr holding an integer value.
+// Parameters:
+// - key: The attribute name.
+// - value: The integer value, stored as int64.
+// Returns:
+// - An Attr with the given key and value.
+func Int(key string, value int) Attr {
+	return Attr{Key: key, Value: int64(value)}
+}
+
+// Bool creates an Attr holding a boolean value.
+// Parameters:
+// - key: The attribute name.
+// - value: The boolean value.
+// Returns:
+// - An Attr with the given key and value.
+func Bool(key string, value bool) Attr {
+	return Attr{Key: key, Value: value}
+}
+
+// Float creates an Attr holding a floating point value.
+// Parameters:
+// - key: The attribute name.
+// - value: The float value.
+// Returns:
+// - An Attr with the given key and value.
+func Float(key string, value float64) Attr {
+	return Attr{Key: key, Value: value}
+}
+
+// Time creates an Attr holding a time value.
+// Parameters:
+// - key: The attribute name.
+// - value: The time value, rendered as RFC3339.
+// Returns:
+// - An Attr with the given key and value.
+func Time(key string, value time.Time) Attr {
+	return Attr{Key: key, Value: value}
+}
+
+// SetMaxBinaryBytes sets how many bytes of a byte slice Hex and Base64 render.
+// Longer slices are cut off and marked with their total length. The default is 64.
+// Parameters:
+// - n: The maximum number of bytes to render. Values below 1 disable the limit.
+func SetMaxBinaryBytes(n int) {
+	maxBinaryBytes.Store(int64(n))
+}
+
+// Hex creates an Attr holding a byte slice rendered as hexadecimal.
+// Parameters:
+// - key: The attribute name.
+// - b: The bytes to render, cut off after SetMaxBinaryBytes bytes.
+// Returns:
+// - An Attr with the given key and the hex encoded bytes.
+func Hex(key string, b []byte) Attr {
+	shown, suffix := limitBinary(b)
+	return Attr{Key: key, Value: hex.EncodeToString(shown) + suffix}
+}
+
+// Base64 creates an Attr holding a byte slice rendered as standard base64.
+// Parameters:
+// - key: The attribute name.
+// - b: The bytes to render, cut off after SetMaxBinaryBytes bytes.
+// Returns:
+// - An Attr with the given key and the base64 encoded bytes.
+func Base64(key string, b []byte) Attr {
+	shown, suffix := limitBinary(b)
+	return Attr{Key: key, Value: base64.StdEncoding.EncodeToString(shown) + suffix}
+}
+
+// limitBinary cuts a byte slice to the configured maximum length.
+// Parameters:
+// - b: The bytes to limit.
+// Returns:
+// - The bytes to render and a suffix like "...(1024 bytes)" if they were cut off.
+func limitBinary(b []byte) ([]byte, string) {
+	limit := int(maxBinaryBytes.Load())
+	if limit < 1 || len(b) <= limit {
+		return b, ""
+	}
+	return b[:limit], fmt.Sprintf("...(%d bytes)", len(b))
+}
+
+// hasAttr reports whether an attribute with the given key exists.
+// Parameters:
+// - attrs: The attributes to search.
+// - key: The attribute name.
+// Returns:
+// - True if one of the attributes uses the key.
+func hasAttr(attrs []Attr, key string) bool {
+	for _, attr := range attrs {
+		if attr.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// appendValue appends the text representation of an attribute value to dst.
+// Parameters:
+// - dst: The buffer to append to.
+// - value: The attribute value.
+// Returns:
+// - The extended buffer.
+func appendValue(dst []byte, value interface{}) []byte {
+	switch v := value.(type) {
+	case string:
+		return append(dst, v...)
+	case int64:
+		return strconv.AppendInt(dst, v, 10)
+	case bool:
+		return strconv.AppendBool(dst, v)
+	case float64:
+		return strconv.AppendFloat(dst, v, 'g', -1, 64)
+	case time.Time:
+		return v.AppendFormat(dst, time.RFC3339)
+	case []string:
+		dst = append(dst, '[')
+		for i, item := range v {
+			if i > 0 {
+				dst = append(dst, ", "...)
+			}
+			dst = append(dst, item...)
+		}
+		return append(dst, ']')
+	case map[string]string:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		dst = append(dst, '{')
+		for i, key := range keys {
+			if i > 0 {
+				dst = append(dst, ", "...)
+			}
+			dst = append(dst, key...)
+			dst = append(dst, '=')
+			dst = append(dst, v[key]...)
+		}
+		return append(dst, '}')
+	default:
+		return fmt.Append(dst, v)
+	}
+}
+
+// appendAttrs appends attributes to dst as space separated key=value pairs.
+// Following logfmt, a value containing spaces, "=", quotes, control characters or
+// invalid UTF-8 is written as a quoted Go string, so parsers never split it apart.
+// Keys cannot be quoted in logfmt, so those characters are replaced with "_" in keys.
+// Parameters:
+// - dst: The buffer to append to.
+// - attrs: The attributes to render.
+// Returns:
+// - The extended buffer.
+func appendAttrs(dst []byte, attrs []Attr) []byte {
+	for i, attr := range attrs {
+		if i > 0 {
+			dst = append(dst, ' ')
+		}
+		dst = appendKey(dst, attr.Key)
+		dst = append(dst, '=')
+
+		start := len(dst)
+		dst = appendValue(dst, attr.Value)
+		if needsQuoting(dst[start:]) {
+			value := string(dst[start:])
+			dst = strconv.AppendQuote(dst[:start], value)
+		}
+	}
+	return dst
+}
+
+// appendKey appends an attribute key, replacing characters that would split the pair.
+// Parameters:
+// - dst: The buffer to append to.
+// - key: The attribute key.
+// Returns:
+// - The extended buffer, with "_" for an empty key.
+func appendKey(dst []byte, key string) []byte {
+	if key == "" {
+		return append(dst, '_')
+	}
+	clean := utf8.ValidString(key)
+	for i := 0; i < len(key) && clean; i++ {
+		clean = key[i] > ' ' && key[i] != '=' && key[i] != '"' && key[i] != 0x7f
+	}
+	if clean {
+		return append(dst, key...)
+	}
+	for _, r := range key {
+		if r <= ' ' || r == '=' || r == '"' || r == 0x7f || r == utf8.RuneError {
+			r = '_'
+		}
+		dst = utf8.AppendRune(dst, r)
+	}
+	return dst
+}
+
+// appendMessage appends the message of a line.
+// The message is free text that comes before the key=value pairs, so spaces are
+// kept, but a message containing "=", quotes, control characters such as newlines
+// or invalid UTF-8 is written as a quoted Go string. Otherwise a logfmt parser would
+// read parts of the message as pairs, or a newline would split the line.
+// Parameters:
+// - dst: The buffer to append to.
+// - msg: The log message.
+// Returns:
+// - The extended buffer.
+func appendMessage(dst []byte, msg string) []byte {
+	for i := 0; i < len(msg); i++ {
+		if b := msg[i]; b < ' ' || b == '=' || b == '"' || b == 0x7f {
+			return strconv.AppendQuote(dst, msg)
+		}
+	}
+	if !utf8.ValidString(msg) {
+		return strconv.AppendQuote(dst, msg)
+	}
+	return append(dst, msg...)
+}
+
+// needsQuoting reports whether a rendered value must be quoted to stay a single logfmt value.
+// Parameters:
+// - value: The rendered value.
+// Returns:
+// - True if the value contains a space, "=", a quote, a control character or invalid UTF-8.
+func needsQuoting(value []byte) bool {
+	for _, b := range value {
+		if b <= ' ' || b == '=' || b == '"' || b == 0x7f {
+			return true
+		}
+	}
+	return !utf8.Valid(value)
+}
@@ -0,0 +1,74 @@
+package Logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestAppendAttrsQuoting(t *testing.T) {
+	tests := []struct {
+		name  string
+		attrs []Attr
+		want  string
+	}{
+		{"plain", []Attr{String("user", "alice")}, "user=alice"},
+		{"empty value", []Attr{String("user", "")}, "user="},
+		{"space", []Attr{String("msg", "hello world")}, `msg="hello world"`},
+		{"equals", []Attr{String("query", "a=b")}, `query="a=b"`},
+		{"quotes", []Attr{String("said", `say "hi"`)}, `said="say \"hi\""`},
+		{"newline", []Attr{String("text", "a\nb")}, `text="a\nb"`},
+		{"invalid utf8", []Attr{String("raw", "\xff")}, `raw="\xff"`},
+		{"unicode", []Attr{String("city", "København")}, "city=København"},
+		{"map", []Attr{{Key: "errors", Value: map[string]string{"email": "invalid"}}}, `errors="{email=invalid}"`},
+		{"key with space", []Attr{String("a b", "x")}, "a_b=x"},
+		{"key with equals and quote", []Attr{String(`a="b`, "x")}, "a__b=x"},
+		{"empty key", []Attr{String("", "x")}, "_=x"},
+		{"several", []Attr{Int("n", 1), Bool("ok", true), String("s", "x y")}, `n=1 ok=true s="x y"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(appendAttrs(nil, tt.attrs)); got != tt.want {
+				t.Errorf("appendAttrs() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendMessageQuoting(t *testing.T) {
+	tests := []struct {
+		msg  string
+		want string
+	}{
+		{"server started", "server started"},
+		{"", ""},
+		{"set x=1", `"set x=1"`},
+		{`file "a.txt" missing`, `"file \"a.txt\" missing"`},
+		{"line one\nline two", `"line one\nline two"`},
+	}
+	for _, tt := range tests {
+		if got := string(appendMessage(nil, tt.msg)); got != tt.want {
+			t.Errorf("appendMessage(%q) = %s, want %s", tt.msg, got, tt.want)
+		}
+	}
+}
+
+func TestLogLineQuoting(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quoting.log")
+	l, err := NewLogger(INFO, path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.Event(`retry "upload"`, String("url", "https://example.com/?a=b"), String("err key", `bad "gateway"`))
+	l.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `INFO: event="retry \"upload\"" url="https://example.com/?a=b" err_key="bad \"gateway\""` + "\n"
+	if !strings.HasSuffix(string(data), want) {
+		t.Errorf("line = %q, want suffix %q", data, want)
+	}
+}
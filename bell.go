@@ -0,0 +1,33 @@
+package Logger
+
+import (
+	"io"
+	"os"
+
+	"github.com/mattn/go-isatty"
+)
+
+// SetBellOnLevel rings the terminal bell for console messages at or above the given level.
+// The bell is only rung when console output is enabled and stdout is a terminal.
+// When built with the "notify" build tag, a desktop notification is shown as well.
+// Bell notifications are off by default; pass OFF to turn them off again.
+// Parameters:
+// - level: The minimum level that rings the bell, e.g. ERROR.
+func (l *Logger) SetBellOnLevel(level LogLevel) {
+	l.bellTTY.Store(isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd()))
+	l.bellLevel.Store(int32(level))
+}
+
+// ringBell rings the terminal bell and shows a desktop notification, if enabled for the level.
+// Parameters:
+// - level: The log level of the message.
+// - msg: The log message, used as the notification body.
+func (l *Logger) ringBell(level LogLevel, msg string) {
+	if !l.logToConsole || l.consoleBroken.Load() || !l.bellTTY.Load() || int32(level) < l.bellLevel.Load() {
+		return
+	}
+	l.consoleMu.Lock()
+	io.WriteString(l.consoleWriter(), "\a")
+	l.consoleMu.Unlock()
+	notify(level, msg)
+}
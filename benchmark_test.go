@@ -0,0 +1,67 @@
+package Logger
+
+import (
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+// newBenchmarkLogger creates a logger that writes to a temporary file only.
+func newBenchmarkLogger(b *testing.B, level LogLevel) *Logger {
+	b.Helper()
+	l, err := NewLogger(level, filepath.Join(b.TempDir(), "bench.log"), false)
+	if err != nil {
+		b.Fatal(err)
+	}
+	// The finalizer prints a line, which would interleave with the benchmark results
+	runtime.SetFinalizer(l, nil)
+	b.Cleanup(l.Close)
+	return l
+}
+
+func BenchmarkDebugDisabled(b *testing.B) {
+	l := newBenchmarkLogger(b, INFO)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Debug("hello", "world")
+	}
+}
+
+func BenchmarkInfo(b *testing.B) {
+	l := newBenchmarkLogger(b, INFO)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Info("hello", "world")
+	}
+}
+
+func BenchmarkLogLevel(b *testing.B) {
+	l := newBenchmarkLogger(b, INFO)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.LogLevel(INFO, "hello world")
+	}
+}
+
+func BenchmarkEventWithFields(b *testing.B) {
+	l := newBenchmarkLogger(b, INFO)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l.Event("request", Int("status", 200), String("method", "GET"), Bool("cached", true))
+	}
+}
+
+func BenchmarkInfoParallel(b *testing.B) {
+	l := newBenchmarkLogger(b, INFO)
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			l.Info("hello", "world")
+		}
+	})
+}
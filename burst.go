@@ -0,0 +1,126 @@
+package Logger
+
+import (
+	"strings"
+	"time"
+)
+
+// burstEndedMessage is the message of burst summaries, which are never sampled themselves.
+const burstEndedMessage = "Burst ended"
+
+// burstSampler samples repeated messages of one level.
+type burstSampler struct {
+	every  int
+	quiet  time.Duration
+	bursts map[string]*burst
+}
+
+// burst tracks one run of identical messages.
+type burst struct {
+	count   int
+	dropped int
+	last    time.Time
+	timer   *time.Timer
+}
+
+// SetBurstSampling samples bursts of identical messages of a level.
+// The first message of a burst is always logged, then one in every of the repeats,
+// e.g. the 1st, 101st and 201st for every=100. Once no identical message arrived for
+// the quiet period, the burst has ended and a "Burst ended" line reports the message,
+// how often it occurred and how many were dropped. Messages are identical when their
+// text matches; attributes are ignored. FATAL messages are never sampled.
+// Parameters:
+// - level: The log level to sample, e.g. WARNING.
+// - every: Log one in this many repeats. Values below 1 disable burst sampling for the level.
+// - quiet: How long a message must not repeat for its burst to end.
+func (l *Logger) SetBurstSampling(level LogLevel, every int, quiet time.Duration) {
+	if level == FATAL {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if previous, exists := l.bursts[level]; exists {
+		previous.stop()
+		delete(l.bursts, level)
+	}
+	if every < 1 {
+		return
+	}
+	if l.bursts == nil {
+		l.bursts = map[LogLevel]*burstSampler{}
+	}
+	l.bursts[level] = &burstSampler{every: every, quiet: quiet, bursts: map[string]*burst{}}
+}
+
+// burstSampled reports whether a message passes burst sampling.
+// Parameters:
+// - level: The log level of the message.
+// - msg: The log message.
+// Returns:
+// - True if the message should be logged.
+func (l *Logger) burstSampled(level LogLevel, msg string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	sampler, exists := l.bursts[level]
+	if !exists || msg == burstEndedMessage {
+		return true
+	}
+
+	current, exists := sampler.bursts[msg]
+	if !exists {
+		current = &burst{}
+		sampler.bursts[msg] = current
+		current.timer = time.AfterFunc(sampler.quiet, func() { l.endBurst(sampler, level, msg, current) })
+	} else {
+		current.timer.Reset(sampler.quiet)
+	}
+	current.count++
+	current.last = time.Now()
+
+	keep := (current.count-1)%sampler.every == 0
+	if !keep {
+		current.dropped++
+	}
+	return keep
+}
+
+// endBurst removes a burst after its quiet period and logs its summary.
+// Parameters:
+// - sampler: The sampler the burst belongs to.
+// - level: The log level of the burst.
+// - msg: The repeated message.
+// - ended: The burst whose timer fired.
+func (l *Logger) endBurst(sampler *burstSampler, level LogLevel, msg string, ended *burst) {
+	l.mu.Lock()
+	if l.bursts[level] != sampler || sampler.bursts[msg] != ended || time.Since(ended.last) < sampler.quiet {
+		// The sampler was replaced, or the timer fired while the burst was extended
+		l.mu.Unlock()
+		return
+	}
+	delete(sampler.bursts, msg)
+	count, dropped := ended.count, ended.dropped
+	l.mu.Unlock()
+
+	if count > 1 {
+		l.log(level, burstEndedMessage, String("message", strings.TrimSpace(msg)), Int("count", count), Int("dropped", dropped))
+	}
+}
+
+// stop stops the timers of all running bursts without logging their summaries.
+// Must be called with l.mu held.
+func (s *burstSampler) stop() {
+	for msg, current := range s.bursts {
+		current.timer.Stop()
+		delete(s.bursts, msg)
+	}
+}
+
+// stopBurstSampling stops the timers of every burst sampler, e.g. when the logger is closed.
+func (l *Logger) stopBurstSampling() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, sampler := range l.bursts {
+		sampler.stop()
+	}
+}
@@ -0,0 +1,101 @@
+package Logger
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBurstSampledKeepsOneInEvery(t *testing.T) {
+	l, _ := newTestLogger(t, DEBUG)
+	l.SetBurstSampling(WARNING, 3, time.Hour)
+
+	var kept []bool
+	for i := 0; i < 7; i++ {
+		kept = append(kept, l.burstSampled(WARNING, "disk full"))
+	}
+	if want := []bool{true, false, false, true, false, false, true}; !reflect.DeepEqual(kept, want) {
+		t.Errorf("kept = %v, want %v", kept, want)
+	}
+
+	if !l.burstSampled(WARNING, "other message") {
+		t.Error("first message of a new burst was dropped")
+	}
+	if !l.burstSampled(ERROR, "disk full") {
+		t.Error("message of an unsampled level was dropped")
+	}
+	if !l.burstSampled(WARNING, burstEndedMessage) {
+		t.Error("burst summary was sampled")
+	}
+}
+
+func TestEndBurstLogsSummary(t *testing.T) {
+	l, path := newTestLogger(t, DEBUG)
+	l.SetBurstSampling(WARNING, 2, time.Hour)
+	for i := 0; i < 3; i++ {
+		l.burstSampled(WARNING, "disk full")
+	}
+
+	l.mu.Lock()
+	sampler := l.bursts[WARNING]
+	current := sampler.bursts["disk full"]
+	l.mu.Unlock()
+
+	// A timer that fires while the burst is still going on does nothing
+	l.endBurst(sampler, WARNING, "disk full", current)
+	if got := readLog(t, path); strings.Contains(got, burstEndedMessage) {
+		t.Fatalf("burst ended before its quiet period:\n%s", got)
+	}
+
+	l.mu.Lock()
+	current.last = time.Now().Add(-2 * time.Hour)
+	l.mu.Unlock()
+	l.endBurst(sampler, WARNING, "disk full", current)
+
+	if got := readLog(t, path); !strings.Contains(got, "WARNING: Burst ended message=\"disk full\" count=3 dropped=1") {
+		t.Fatalf("summary missing:\n%s", got)
+	}
+	l.mu.Lock()
+	_, exists := sampler.bursts["disk full"]
+	l.mu.Unlock()
+	if exists {
+		t.Error("ended burst was not removed")
+	}
+}
+
+func TestEndBurstIgnoresReplacedSampler(t *testing.T) {
+	l, path := newTestLogger(t, DEBUG)
+	l.SetBurstSampling(WARNING, 2, time.Hour)
+	l.burstSampled(WARNING, "disk full")
+	l.burstSampled(WARNING, "disk full")
+
+	l.mu.Lock()
+	sampler := l.bursts[WARNING]
+	current := sampler.bursts["disk full"]
+	current.last = time.Now().Add(-2 * time.Hour)
+	l.mu.Unlock()
+
+	l.SetBurstSampling(WARNING, 5, time.Hour)
+	l.endBurst(sampler, WARNING, "disk full", current)
+	if got := readLog(t, path); strings.Contains(got, burstEndedMessage) {
+		t.Fatalf("replaced sampler logged a summary:\n%s", got)
+	}
+}
+
+func TestBurstEndsAfterQuietPeriod(t *testing.T) {
+	l, path := newTestLogger(t, DEBUG)
+	l.SetBurstSampling(WARNING, 10, 50*time.Millisecond)
+	for i := 0; i < 5; i++ {
+		l.Warning("retrying")
+	}
+	time.Sleep(150 * time.Millisecond)
+
+	got := readLog(t, path)
+	if n := strings.Count(got, "WARNING: retrying"); n != 1 {
+		t.Errorf("burst logged %d times, want 1:\n%s", n, got)
+	}
+	if !strings.Contains(got, "Burst ended message=retrying count=5 dropped=4") {
+		t.Errorf("summary missing:\n%s", got)
+	}
+}
@@ -0,0 +1,95 @@
+package Logger
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// LogEntry is a single log message as delivered to channel outputs.
+// Name is the name of the logger that wrote it, e.g. "db.pool" for a derived logger.
+type LogEntry struct {
+	Time    time.Time
+	Level   LogLevel
+	Name    string
+	Message string
+	Attrs   []Attr
+}
+
+// channelOutput delivers log entries to a channel owned by the caller.
+type channelOutput struct {
+	ch          chan<- LogEntry
+	nonBlocking bool
+	dropped     atomic.Int64
+}
+
+// AddChannelOutput sends every logged entry to the given channel.
+// In blocking mode, logging waits until the channel accepts the entry, so a slow
+// consumer applies backpressure to the program. In non-blocking mode, entries are
+// dropped when the channel is full and counted (see DroppedChannelEntries).
+// The logger never closes the channel.
+// Parameters:
+// - ch: The channel receiving the entries.
+// - nonBlocking: Whether to drop entries instead of waiting when the channel is full.
+func (l *Logger) AddChannelOutput(ch chan<- LogEntry, nonBlocking bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.channelOutputs = append(l.channelOutputs, &channelOutput{ch: ch, nonBlocking: nonBlocking})
+}
+
+// RemoveChannelOutput stops sending entries to the given channel.
+// Parameters:
+// - ch: The channel previously passed to AddChannelOutput.
+func (l *Logger) RemoveChannelOutput(ch chan<- LogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	outputs := make([]*channelOutput, 0, len(l.channelOutputs))
+	for _, output := range l.channelOutputs {
+		if output.ch != ch {
+			outputs = append(outputs, output)
+		}
+	}
+	l.channelOutputs = outputs
+}
+
+// DroppedChannelEntries returns how many entries were dropped for a non-blocking channel output.
+// Parameters:
+// - ch: The channel previously passed to AddChannelOutput.
+// Returns:
+// - The number of dropped entries, or 0 if the channel is not registered.
+func (l *Logger) DroppedChannelEntries(ch chan<- LogEntry) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, output := range l.channelOutputs {
+		if output.ch == ch {
+			return output.dropped.Load()
+		}
+	}
+	return 0
+}
+
+// writeChannelOutputs sends an entry to all channel outputs.
+// The lock is not held while sending, so a blocked channel does not block the logger's other state.
+// Parameters:
+// - entry: The entry to send.
+// Returns:
+// - The number of channel outputs and how many of them dropped the entry.
+func (l *Logger) writeChannelOutputs(entry LogEntry) (outputs, failed int) {
+	l.mu.Lock()
+	channels := l.channelOutputs
+	l.mu.Unlock()
+
+	for _, output := range channels {
+		if !output.nonBlocking {
+			output.ch <- entry
+			continue
+		}
+
+		select {
+		case output.ch <- entry:
+		default:
+			output.dropped.Add(1)
+			failed++
+		}
+	}
+	return len(channels), failed
+}
@@ -0,0 +1,70 @@
+// Package cobraflag wires Logger levels into spf13/cobra commands.
+// It is a separate module, github.com/StarGames2025/Logger/cobraflag, so that only
+// programs using cobra depend on it.
+package cobraflag
+
+import (
+	"strings"
+
+	"github.com/StarGames2025/Logger"
+	"github.com/spf13/cobra"
+)
+
+// levelValue is a pflag.Value that parses log level names with Logger.ParseLevel.
+type levelValue struct {
+	level *Logger.LogLevel
+}
+
+// String returns the name of the current level.
+// Returns:
+// - The level name.
+func (v *levelValue) String() string {
+	return v.level.String()
+}
+
+// Set parses and stores a level name.
+// Parameters:
+// - value: The level name given on the command line.
+// Returns:
+// - An error if the name is not a valid log level.
+func (v *levelValue) Set(value string) error {
+	level, err := Logger.ParseLevel(value)
+	if err != nil {
+		return err
+	}
+	*v.level = level
+	return nil
+}
+
+// Type returns the type name shown in cobra's help output.
+// Returns:
+// - The value type name.
+func (v *levelValue) Type() string {
+	return "level"
+}
+
+// levelNames returns the names of all log levels in order of severity.
+// Returns:
+// - The level names from DEBUG to OFF.
+func levelNames() []string {
+	var names []string
+	for level := Logger.DEBUG; level <= Logger.OFF; level++ {
+		names = append(names, level.String())
+	}
+	return names
+}
+
+// AddLevelFlag adds a persistent --log-level flag to the command.
+// The flag is parsed with Logger.ParseLevel and offers shell completion for the level names.
+// The current value of target is used as the default.
+// Parameters:
+// - cmd: The command to add the flag to, usually the root command.
+// - target: The level to populate when the flag is parsed.
+func AddLevelFlag(cmd *cobra.Command, target *Logger.LogLevel) {
+	names := levelNames()
+	cmd.PersistentFlags().Var(&levelValue{level: target}, "log-level",
+		"log level ("+strings.Join(names, ", ")+")")
+	cmd.RegisterFlagCompletionFunc("log-level", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return names, cobra.ShellCompDirectiveNoFileComp
+	})
+}
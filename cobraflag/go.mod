@@ -0,0 +1,17 @@
+module github.com/StarGames2025/Logger/cobraflag
+
+go 1.24.1
+
+require (
+	github.com/StarGames2025/Logger v0.0.0-20261014082943-7e88ccab8208
+	github.com/spf13/cobra v1.10.1
+)
+
+require (
+	github.com/fatih/color v1.18.0 // indirect
+	github.com/inconshreveable/mousetrap v1.1.0 // indirect
+	github.com/mattn/go-colorable v0.1.13 // indirect
+	github.com/mattn/go-isatty v0.0.20 // indirect
+	github.com/spf13/pflag v1.0.9 // indirect
+	golang.org/x/sys v0.25.0 // indirect
+)
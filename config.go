@@ -0,0 +1,86 @@
+package Logger
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// configAttrs describes the effective configuration of the logger as attributes.
+// Returns:
+// - The configuration attributes, in a stable order.
+func (l *Logger) configAttrs() []Attr {
+	file := ""
+	if l.logFile != nil {
+		file = l.logFile.Name()
+	}
+
+	l.mu.Lock()
+	crashLog := ""
+	if l.crashLog != nil {
+		crashLog = l.crashLog.Name()
+	}
+	attrs := []Attr{
+		String("name", l.name),
+		String("level", l.currentLevel().String()),
+		String("file", file),
+		Bool("console", l.logToConsole),
+		String("environment", loadString(&l.environment)),
+		Int("max_fields", int(l.maxFields.Load())),
+		Int("max_line_length", int(l.maxLineLength.Load())),
+		String("line_terminator", loadString(&l.lineTerminator)),
+		String("crash_log", crashLog),
+		String("pid_file", l.pidFile),
+		Int("channel_outputs", len(l.channelOutputs)),
+		Int("field_providers", len(l.fieldProviders)),
+		Int("exit_codes", len(l.exitCodes)),
+		Int("outputs", len(l.outputs)+len(l.sharedOutputs)),
+		Int("sensitive_keys", len(l.sensitiveKeys)),
+	}
+	if len(l.samplers) > 0 {
+		sampling := make(map[string]string, len(l.samplers))
+		for level, sampler := range l.samplers {
+			sampling[level.String()] = strconv.Itoa(sampler.maxPerSecond) + "/s"
+		}
+		attrs = append(attrs, Attr{Key: "sampling", Value: sampling})
+	}
+	if len(l.bursts) > 0 {
+		bursts := make(map[string]string, len(l.bursts))
+		for level, sampler := range l.bursts {
+			bursts[level.String()] = "1/" + strconv.Itoa(sampler.every) + " quiet " + sampler.quiet.String()
+		}
+		attrs = append(attrs, Attr{Key: "burst_sampling", Value: bursts})
+	}
+	if !l.muteUntil.IsZero() {
+		attrs = append(attrs, String("mute_level", l.muteLevel.String()), Time("mute_until", l.muteUntil))
+	}
+	l.mu.Unlock()
+
+	globalSinksMu.Lock()
+	attrs = append(attrs, Int("global_sinks", len(globalSinks)))
+	globalSinksMu.Unlock()
+
+	return attrs
+}
+
+// LogConfig logs the effective configuration of the logger as a single INFO entry.
+// This makes it easy to verify from the logs how the logger is configured in each environment.
+// Outputs are described by their path or count only; writers are never dumped.
+func (l *Logger) LogConfig() {
+	l.log(INFO, "Logger configuration:", l.configAttrs()...)
+}
+
+// ConfigJSON returns the effective configuration of the logger as a JSON object.
+// It describes the same settings as LogConfig, but returns them instead of logging them,
+// so tooling such as a debug endpoint can consume them. Values of keys matching the
+// sensitive keys set with SetSensitiveKeys are redacted as "***".
+// Returns:
+// - The configuration as a JSON object with one member per setting.
+// - An error if the configuration could not be encoded.
+func (l *Logger) ConfigJSON() ([]byte, error) {
+	attrs := l.maskAttrs(l.configAttrs())
+	config := make(map[string]interface{}, len(attrs))
+	for _, attr := range attrs {
+		config[attr.Key] = attr.Value
+	}
+	return json.Marshal(config)
+}
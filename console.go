@@ -0,0 +1,91 @@
+package Logger
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+	"syscall"
+	"time"
+)
+
+// SetConsoleBuffering buffers console output independently of the log file.
+// The log file is always written synchronously, so a slow terminal (e.g. over SSH)
+// does not delay durable file logging. Buffered console lines are written when the
+// buffer is full, on Flush (including StartAutoFlush) and on Close, which also runs
+// before a FATAL exit. Passing 0 flushes any buffered lines and turns buffering off.
+// Parameters:
+// - size: The buffer size in bytes, or 0 for unbuffered console output.
+func (l *Logger) SetConsoleBuffering(size int) {
+	l.consoleMu.Lock()
+	defer l.consoleMu.Unlock()
+	if l.console != nil {
+		l.console.Flush()
+		l.console = nil
+	}
+	if size > 0 {
+		l.console = bufio.NewWriterSize(os.Stdout, size)
+	}
+}
+
+// consoleWriter returns the writer for console output.
+// The caller must hold consoleMu.
+// Returns:
+// - The console buffer if buffering is enabled, os.Stdout otherwise.
+func (l *Logger) consoleWriter() io.Writer {
+	if l.console != nil {
+		return l.console
+	}
+	return os.Stdout
+}
+
+// flushConsole writes any buffered console output.
+// Returns:
+// - An error if writing to the console fails.
+func (l *Logger) flushConsole() error {
+	l.consoleMu.Lock()
+	if l.console == nil {
+		l.consoleMu.Unlock()
+		return nil
+	}
+	err := l.console.Flush()
+	l.consoleMu.Unlock()
+
+	l.handleConsoleError(err)
+	return err
+}
+
+// handleConsoleError disables console output after a write failed with a broken pipe,
+// e.g. when the program's output is piped into "head" which has exited.
+// A single WARNING noting this is written to the log file only, regardless of the level;
+// later messages skip the console.
+// Note that Go terminates the program on a broken stdout pipe unless SIGPIPE is
+// handled, e.g. with signal.Ignore(syscall.SIGPIPE); only then does the write fail with EPIPE.
+// Parameters:
+// - err: The error returned by the console write.
+func (l *Logger) handleConsoleError(err error) {
+	if err == nil || !errors.Is(err, syscall.EPIPE) {
+		return
+	}
+	if l.consoleBroken.CompareAndSwap(false, true) {
+		l.writeFileNote(WARNING, "Console output is a broken pipe. Disabling console logging.")
+	}
+}
+
+// writeFileNote writes a line about the logger itself to the log file only.
+// Unlike log, it ignores the level, mute and sampling filters and is not passed
+// to global sinks, channel outputs or remote outputs.
+// Parameters:
+// - level: The log level shown in the line.
+// - msg: The note, written as is.
+func (l *Logger) writeFileNote(level LogLevel, msg string) {
+	if l.logFile == nil {
+		return
+	}
+	line := "[" + time.Now().Format(timestampLayout) + "] " + level.String() + ": "
+	if l.name != "" {
+		line += "[" + l.name + "] "
+	}
+	line += msg + loadString(&l.lineTerminator)
+	l.logFile.WriteString(line)
+}
@@ -0,0 +1,27 @@
+package Logger
+
+import (
+	"strings"
+	"syscall"
+	"testing"
+)
+
+func TestBrokenPipeNoteWrittenToFileOnly(t *testing.T) {
+	l, path := newTestLogger(t, ERROR)
+	entries := make(chan LogEntry, 4)
+	l.AddChannelOutput(entries, true)
+
+	l.handleConsoleError(syscall.EPIPE)
+	l.handleConsoleError(syscall.EPIPE)
+
+	got := readLog(t, path)
+	if n := strings.Count(got, "WARNING: Console output is a broken pipe."); n != 1 {
+		t.Fatalf("note written %d times, want once despite level ERROR:\n%s", n, got)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("note was sent to a channel output")
+	}
+	if !l.consoleBroken.Load() {
+		t.Fatalf("console not disabled")
+	}
+}
@@ -0,0 +1,45 @@
+package Logger
+
+import "sync/atomic"
+
+// Count increments the named counter and returns its new value.
+// If a level was set with SetCountLevel, the new value is also logged as
+// "counter=<name> value=<n>". Counters are safe for concurrent use.
+// Parameters:
+// - name: The counter name, e.g. "retries".
+// Returns:
+// - The value of the counter after incrementing it.
+func (l *Logger) Count(name string) int64 {
+	counter, _ := l.counters.LoadOrStore(name, new(atomic.Int64))
+	value := counter.(*atomic.Int64).Add(1)
+
+	if level := LogLevel(l.countLevel.Load()); level != OFF && l.enabled(level) {
+		l.log(level, "", String("counter", name), Attr{Key: "value", Value: value})
+	}
+	return value
+}
+
+// Counts returns the current value of the named counter without changing it.
+// Parameters:
+// - name: The counter name.
+// Returns:
+// - The counter value, or 0 if the counter was never incremented.
+func (l *Logger) Counts(name string) int64 {
+	counter, exists := l.counters.Load(name)
+	if !exists {
+		return 0
+	}
+	return counter.(*atomic.Int64).Load()
+}
+
+// SetCountLevel makes Count log every new counter value at the given level.
+// Counting is silent by default; pass OFF to stop logging counter values again.
+// FATAL is logged as ERROR so that incrementing a counter never exits the program.
+// Parameters:
+// - level: The log level for counter values.
+func (l *Logger) SetCountLevel(level LogLevel) {
+	if level == FATAL {
+		level = ERROR
+	}
+	l.countLevel.Store(int32(level))
+}
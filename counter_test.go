@@ -0,0 +1,19 @@
+package Logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCountLevelFatalDoesNotExit(t *testing.T) {
+	l, path := newTestLogger(t, DEBUG)
+	l.SetCountLevel(FATAL)
+	if got := l.Count("retries"); got != 1 {
+		t.Fatalf("Count = %d, want 1", got)
+	}
+
+	// Reaching this line means Count did not exit the test binary
+	if got := readLog(t, path); !strings.Contains(got, "ERROR: counter=retries value=1") {
+		t.Fatalf("counter not logged as ERROR:\n%s", got)
+	}
+}
@@ -0,0 +1,89 @@
+package Logger
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// EnableCrashLog keeps a small "last breath" file that always holds the most recent log line.
+// Every line is written to it synchronously and flushed to disk with fsync, so the
+// last thing that happened survives a hard crash. The file is removed by Close on a
+// normal shutdown and kept when the program exits through a FATAL message.
+// Calling EnableCrashLog again replaces the previous crash log, which is removed
+// unless it has the same path.
+// Parameters:
+// - path: The path to the crash log file.
+// Returns:
+// - An error if the file cannot be created.
+func (l *Logger) EnableCrashLog(path string) error {
+	l.mu.Lock()
+	previous := l.crashLog
+	l.crashLog = nil
+	l.mu.Unlock()
+
+	if previous != nil {
+		previous.Close()
+		if !samePath(previous.Name(), path) {
+			os.Remove(previous.Name())
+		}
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	l.crashLog = file
+	l.mu.Unlock()
+	return nil
+}
+
+// writeCrashLog replaces the contents of the crash log with the given line and syncs it to disk.
+// Parameters:
+// - line: The formatted log line.
+func (l *Logger) writeCrashLog(line []byte) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.crashLog == nil {
+		return
+	}
+
+	// Write before truncating, so a crash in between never leaves an empty file
+	l.crashLog.WriteAt(line, 0)
+	l.crashLog.Truncate(int64(len(line)))
+	l.crashLog.Sync()
+}
+
+// closeCrashLog closes the crash log, if enabled.
+// Parameters:
+// - remove: Whether to delete the file after closing it.
+func (l *Logger) closeCrashLog(remove bool) {
+	l.mu.Lock()
+	file := l.crashLog
+	l.crashLog = nil
+	l.mu.Unlock()
+
+	if file == nil {
+		return
+	}
+	file.Close()
+	if remove {
+		os.Remove(file.Name())
+	}
+}
+
+// samePath reports whether two paths refer to the same file name.
+// Parameters:
+// - a: The first path.
+// - b: The second path.
+// Returns:
+// - True if both paths are equal once made absolute.
+func samePath(a, b string) bool {
+	absA, errA := filepath.Abs(a)
+	absB, errB := filepath.Abs(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return absA == absB
+}
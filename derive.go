@@ -0,0 +1,89 @@
+package Logger
+
+import "reflect"
+
+// Derive creates a child logger from a snapshot of this logger's configuration.
+// The child copies the parent's settings (level, console and formatting options,
+// timestamps, environment, exit codes, field providers, encoders, sensitive keys,
+// sampling limits and the fail-on-all-outputs-down policy) and
+// writes to the same log file, channel outputs and remote outputs such as syslog
+// and journald. Each line of the child is prefixed with its name in brackets, and
+// the name is passed to structured outputs as LogEntry.Name (the syslog MSGID and
+// the journald LOGGER field). Deriving from a named logger produces a dotted name
+// such as "db.pool".
+//
+// Derive uses snapshot semantics: changes made to the parent afterwards do NOT
+// propagate to the child, and changes to the child never affect the parent.
+// Mute windows, once-keys, counters, console buffering, the crash log, the PID file
+// and the spill file are not inherited. The parent keeps ownership of the log file
+// and its remote outputs, so closing the child does not close them, and the parent
+// must not be closed while children are still logging.
+// Parameters:
+// - name: The name of the child logger, e.g. "db".
+// Returns:
+// - A pointer to the new child Logger.
+func (parent *Logger) Derive(name string) *Logger {
+	if parent.name != "" {
+		name = parent.name + "." + name
+	}
+
+	parent.mu.Lock()
+	defer parent.mu.Unlock()
+
+	child := &Logger{
+		name:            name,
+		logFile:         parent.logFile,
+		logToConsole:    parent.logToConsole,
+		start:           parent.start,
+		exitCodes:       make(map[string]int, len(parent.exitCodes)),
+		statusLevelFunc: parent.statusLevelFunc,
+		channelOutputs:  append([]*channelOutput(nil), parent.channelOutputs...),
+		sharedOutputs:   append(append([]entryOutput(nil), parent.sharedOutputs...), parent.outputs...),
+		fieldProviders:  append([]func() Fields(nil), parent.fieldProviders...),
+		sensitiveKeys:   parent.sensitiveKeys,
+	}
+	child.level.Store(parent.level.Load())
+	child.lineTerminator.Store(parent.lineTerminator.Load())
+	child.monotonic.Store(parent.monotonic.Load())
+	child.delta.Store(parent.delta.Load())
+	child.collapseSpace.Store(parent.collapseSpace.Load())
+	child.emptyPolicy.Store(parent.emptyPolicy.Load())
+	child.environment.Store(parent.environment.Load())
+	child.maxFields.Store(parent.maxFields.Load())
+	child.maxLineLength.Store(parent.maxLineLength.Load())
+	child.fatalDowngrade.Store(parent.fatalDowngrade.Load())
+	child.emojiMode.Store(parent.emojiMode.Load())
+	// The symbols are copied on write, so the child can share the parent's array
+	child.levelSymbols.Store(parent.levelSymbols.Load())
+	child.bellLevel.Store(parent.bellLevel.Load())
+	child.bellTTY.Store(parent.bellTTY.Load())
+	child.consoleWrap.Store(parent.consoleWrap.Load())
+	child.consoleWidth.Store(parent.consoleWidth.Load())
+	child.countLevel.Store(parent.countLevel.Load())
+	child.failAllDown.Store(parent.failAllDown.Load())
+	child.downAttempts.Store(parent.downAttempts.Load())
+	child.downHandler.Store(parent.downHandler.Load())
+	for exitName, code := range parent.exitCodes {
+		child.exitCodes[exitName] = code
+	}
+	for valueType, fn := range parent.encoders {
+		if child.encoders == nil {
+			child.encoders = map[reflect.Type]func(interface{}) interface{}{}
+		}
+		child.encoders[valueType] = fn
+	}
+	for level, sampler := range parent.samplers {
+		if child.samplers == nil {
+			child.samplers = map[LogLevel]*adaptiveSampler{}
+		}
+		child.samplers[level] = &adaptiveSampler{maxPerSecond: sampler.maxPerSecond, windowStart: sampler.windowStart, rate: 1}
+	}
+	for level, sampler := range parent.bursts {
+		if child.bursts == nil {
+			child.bursts = map[LogLevel]*burstSampler{}
+		}
+		child.bursts[level] = &burstSampler{every: sampler.every, quiet: sampler.quiet, bursts: map[string]*burst{}}
+	}
+
+	return child
+}
@@ -0,0 +1,78 @@
+package Logger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func newTestLogger(t *testing.T, level LogLevel) (*Logger, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.log")
+	l, err := NewLogger(level, path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(l.Close)
+	return l, path
+}
+
+func readLog(t *testing.T, path string) string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestDeriveSnapshotSemantics(t *testing.T) {
+	parent, path := newTestLogger(t, INFO)
+	parent.SetEnvironment("staging")
+	child := parent.Derive("db")
+
+	// Changes to the parent after Derive do not reach the child
+	parent.SetLevel(ERROR)
+	parent.SetEnvironment("prod")
+	child.Info("child info")
+
+	// Changes to the child do not reach the parent
+	child.SetLevel(DEBUG)
+	parent.Debug("parent debug")
+	parent.Info("parent info")
+
+	output := readLog(t, path)
+	if !strings.Contains(output, "INFO: [db] child info") || !strings.Contains(output, "env=staging") {
+		t.Errorf("child line missing or changed by the parent:\n%s", output)
+	}
+	if strings.Contains(output, "parent debug") || strings.Contains(output, "parent info") {
+		t.Errorf("parent logged below its own level:\n%s", output)
+	}
+}
+
+func TestDeriveNestedName(t *testing.T) {
+	parent, path := newTestLogger(t, INFO)
+	ch := make(chan LogEntry, 1)
+	parent.AddChannelOutput(ch, true)
+
+	parent.Derive("db").Derive("pool").Info("connected")
+
+	if output := readLog(t, path); !strings.Contains(output, "INFO: [db.pool] connected") {
+		t.Errorf("line = %q, want the dotted name", output)
+	}
+	if entry := <-ch; entry.Name != "db.pool" {
+		t.Errorf("LogEntry.Name = %q, want db.pool", entry.Name)
+	}
+}
+
+func TestDeriveCloseKeepsParentFile(t *testing.T) {
+	parent, path := newTestLogger(t, INFO)
+	child := parent.Derive("worker")
+	child.Close()
+
+	parent.Info("still open")
+	if output := readLog(t, path); !strings.Contains(output, "still open") {
+		t.Errorf("parent could not write after the child was closed:\n%s", output)
+	}
+}
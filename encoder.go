@@ -0,0 +1,51 @@
+package Logger
+
+import "reflect"
+
+// RegisterEncoder registers how values of a specific type are rendered.
+// Every attribute value with the same type as sample is passed through fn before
+// it is written, and channel outputs receive the encoded value as well. The result
+// should be a printable value such as a string or a number.
+// Registering an encoder for a type again replaces the previous one.
+// Parameters:
+// - sample: A value of the type to encode, e.g. time.Time{} or UserID("").
+// - fn: The function converting a value of that type into its rendered form.
+func (l *Logger) RegisterEncoder(sample interface{}, fn func(interface{}) interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.encoders == nil {
+		l.encoders = map[reflect.Type]func(interface{}) interface{}{}
+	}
+	l.encoders[reflect.TypeOf(sample)] = fn
+}
+
+// encodeAttrs applies the registered encoders to the attribute values.
+// Parameters:
+// - attrs: The attributes to encode. The slice is not modified.
+// Returns:
+// - The attributes with encoded values, or attrs itself if nothing was encoded.
+func (l *Logger) encodeAttrs(attrs []Attr) []Attr {
+	l.mu.Lock()
+	encoders := l.encoders
+	l.mu.Unlock()
+
+	if len(encoders) == 0 {
+		return attrs
+	}
+
+	var encoded []Attr
+	for i, attr := range attrs {
+		fn, exists := encoders[reflect.TypeOf(attr.Value)]
+		if !exists {
+			continue
+		}
+		if encoded == nil {
+			encoded = append([]Attr(nil), attrs...)
+		}
+		encoded[i].Value = fn(attr.Value)
+	}
+	if encoded == nil {
+		return attrs
+	}
+	return encoded
+}
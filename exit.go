@@ -0,0 +1,13 @@
+//go:build !testlogger
+
+package Logger
+
+import "os"
+
+// exit terminates the program with the given exit code.
+// Build with the "testlogger" tag to keep FATAL messages from exiting test binaries.
+// Parameters:
+// - code: The exit code.
+func exit(code int) {
+	os.Exit(code)
+}
@@ -0,0 +1,139 @@
+//go:build !testlogger
+
+package Logger
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fatalHelperEnv names the log file when the test binary runs as a FATAL helper process.
+const fatalHelperEnv = "LOGGER_FATAL_HELPER_FILE"
+
+// runFatalHelper runs the calling test again in a child process, where fn is
+// expected to exit the program, and returns the exit code and the log file contents.
+func runFatalHelper(t *testing.T, fn func(l *Logger)) (int, string) {
+	t.Helper()
+
+	if path := os.Getenv(fatalHelperEnv); path != "" {
+		l, err := NewLogger(OFF, path, false)
+		if err != nil {
+			os.Exit(100)
+		}
+		fn(l)
+		os.Exit(101)
+	}
+
+	path := filepath.Join(t.TempDir(), "fatal.log")
+	cmd := exec.Command(os.Args[0], "-test.run=^"+t.Name()+"$")
+	cmd.Env = append(os.Environ(), fatalHelperEnv+"="+path)
+	err := cmd.Run()
+
+	code := 0
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		code = exitErr.ExitCode()
+	} else if err != nil {
+		t.Fatalf("running helper process: %v", err)
+	}
+
+	data, _ := os.ReadFile(path)
+	return code, string(data)
+}
+
+func TestFatalExitsWhenLevelOff(t *testing.T) {
+	code, output := runFatalHelper(t, func(l *Logger) {
+		l.SetExitCode("TEST_ERROR", 3)
+		l.Fatal("TEST_ERROR", "fatal while off")
+	})
+	if code != 3 {
+		t.Errorf("exit code = %d, want 3", code)
+	}
+	if output != "" {
+		t.Errorf("log file = %q, want it empty at level OFF", output)
+	}
+}
+
+func TestFatalCodeExitsWhenLevelOff(t *testing.T) {
+	code, output := runFatalHelper(t, func(l *Logger) {
+		l.FatalCode(7, "fatal while off")
+	})
+	if code != 7 {
+		t.Errorf("exit code = %d, want 7", code)
+	}
+	if output != "" {
+		t.Errorf("log file = %q, want it empty at level OFF", output)
+	}
+}
+
+func TestLogLevelFatalExitsWhenLevelOff(t *testing.T) {
+	code, output := runFatalHelper(t, func(l *Logger) {
+		l.LogLevel(FATAL, "fatal while off")
+	})
+	if code != 255 {
+		t.Errorf("exit code = %d, want 255 (the ERROR exit code)", code)
+	}
+	if output != "" {
+		t.Errorf("log file = %q, want it empty at level OFF", output)
+	}
+}
+
+func TestLogStringFatalExitsWhenLevelOff(t *testing.T) {
+	code, _ := runFatalHelper(t, func(l *Logger) {
+		l.LogString("fatal", "fatal while off")
+	})
+	if code != 255 {
+		t.Errorf("exit code = %d, want 255 (the ERROR exit code)", code)
+	}
+}
+
+func TestFatalDowngradeDoesNotExit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "downgrade.log")
+	l, err := NewLogger(INFO, path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	l.SetFatalDowngrade(true)
+	l.Fatal("ERROR", "downgraded")
+	l.LogLevel(FATAL, "downgraded again")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Count(string(data), "ERROR: downgraded"); got != 2 {
+		t.Errorf("log file = %q, want two ERROR lines", data)
+	}
+}
+
+func TestFatalDowngradeAtFatalLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "downgrade.log")
+	l, err := NewLogger(FATAL, path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	l.SetFatalDowngrade(true)
+	l.Fatal("ERROR", "downgraded")
+	l.FatalCode(7, "downgraded")
+	l.LogLevel(FATAL, "downgraded")
+	l.Error("plain error")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Count(string(data), "ERROR: downgraded"); got != 3 {
+		t.Errorf("log file = %q, want three downgraded ERROR lines", data)
+	}
+	if strings.Contains(string(data), "plain error") {
+		t.Errorf("log file = %q, want plain ERROR filtered at level FATAL", data)
+	}
+}
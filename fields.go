@@ -0,0 +1,40 @@
+package Logger
+
+import "sort"
+
+// Fields is a set of structured fields keyed by name.
+type Fields map[string]interface{}
+
+// Merge combines two field sets into a new one.
+// Neither f nor other is modified. On key collisions, the value from other wins.
+// Parameters:
+// - other: The fields to merge into a copy of f.
+// Returns:
+// - A new Fields containing the keys of both.
+func (f Fields) Merge(other Fields) Fields {
+	merged := make(Fields, len(f)+len(other))
+	for key, value := range f {
+		merged[key] = value
+	}
+	for key, value := range other {
+		merged[key] = value
+	}
+	return merged
+}
+
+// attrs converts the fields into attributes sorted by key.
+// Returns:
+// - One Attr per field, in key order.
+func (f Fields) attrs() []Attr {
+	keys := make([]string, 0, len(f))
+	for key := range f {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]Attr, 0, len(keys))
+	for _, key := range keys {
+		attrs = append(attrs, Attr{Key: key, Value: f[key]})
+	}
+	return attrs
+}
@@ -0,0 +1,47 @@
+package Logger
+
+import "flag"
+
+// levelFlag is a flag.Value that parses log level names with ParseLevel.
+type levelFlag struct {
+	level *LogLevel
+}
+
+// String returns the name of the current level.
+// Returns:
+// - The level name, or "" for the zero value used by the flag package.
+func (f *levelFlag) String() string {
+	if f == nil || f.level == nil {
+		return ""
+	}
+	return f.level.String()
+}
+
+// Set parses and stores a level name.
+// Parameters:
+// - value: The level name given on the command line.
+// Returns:
+// - An error if the name is not a valid log level.
+func (f *levelFlag) Set(value string) error {
+	level, err := ParseLevel(value)
+	if err != nil {
+		return err
+	}
+	*f.level = level
+	return nil
+}
+
+// RegisterLevelFlag registers a log level flag on the given flag set.
+// The returned pointer holds def until the flag set is parsed, and the parsed level afterwards.
+// Invalid level names are rejected by the flag set like any other malformed flag value.
+// Parameters:
+// - fs: The flag set to register the flag on, e.g. flag.CommandLine.
+// - name: The flag name, e.g. "log-level".
+// - def: The default level.
+// Returns:
+// - A pointer to the level, populated after fs.Parse.
+func RegisterLevelFlag(fs *flag.FlagSet, name string, def LogLevel) *LogLevel {
+	level := def
+	fs.Var(&levelFlag{level: &level}, name, "log `level` (DEBUG, INFO, WARNING, ERROR, FATAL or OFF)")
+	return &level
+}
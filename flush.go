@@ -0,0 +1,46 @@
+package Logger
+
+import (
+	"log"
+	"time"
+)
+
+// Flush commits everything written so far.
+// Buffered console output (see SetConsoleBuffering) is written to the terminal.
+// Lines are written to the log file without buffering, so Flush syncs the file to disk.
+// Returns:
+// - An error if writing the console buffer or syncing the log file fails.
+func (l *Logger) Flush() error {
+	consoleErr := l.flushConsole()
+	if l.logFile == nil {
+		return consoleErr
+	}
+	if err := l.logFile.Sync(); err != nil {
+		return err
+	}
+	return consoleErr
+}
+
+// StartAutoFlush calls Flush every interval in a background goroutine, until the
+// returned function is called or the logger is closed. Only one auto flush runs at a
+// time; calling StartAutoFlush again replaces it.
+// Parameters:
+// - interval: The time between two flushes, e.g. time.Second. Must be positive.
+// Returns:
+// - A function that stops the auto flush, which may be called repeatedly. If the
+// interval is not positive, no auto flush is started and it does nothing.
+func (l *Logger) StartAutoFlush(interval time.Duration) (stop func()) {
+	if interval <= 0 {
+		log.Printf("Invalid auto flush interval %s. The auto flush is not started.\n", interval)
+		return func() {}
+	}
+
+	stop = startTicker(interval, func() { l.Flush() })
+	l.replaceStop(&l.stopAutoFlush, stop)
+	return stop
+}
+
+// stopAutoFlushing stops the auto flush started by StartAutoFlush, if any.
+func (l *Logger) stopAutoFlushing() {
+	l.replaceStop(&l.stopAutoFlush, nil)
+}
@@ -0,0 +1,36 @@
+package Logger
+
+import (
+	"log"
+	"time"
+)
+
+// StartHeartbeat logs an INFO heartbeat every interval in a background goroutine, so
+// watchers can tell a quiet service from a dead one. Each heartbeat carries an
+// increasing "seq" attribute starting at 1, which makes gaps in the log easy to detect.
+// A logger has at most one heartbeat: a second call replaces the first, and Close ends it.
+// Parameters:
+// - interval: The time between two heartbeats. Must be positive.
+// - msg: The heartbeat message, e.g. "alive".
+// Returns:
+// - A function that stops the heartbeat. Without a positive interval, no heartbeat
+// is started and the function has no effect.
+func (l *Logger) StartHeartbeat(interval time.Duration, msg string) (stop func()) {
+	if interval <= 0 {
+		log.Printf("Invalid heartbeat interval %s. The heartbeat is not started.\n", interval)
+		return func() {}
+	}
+
+	var seq int
+	stop = startTicker(interval, func() {
+		seq++
+		l.log(INFO, msg, Int("seq", seq))
+	})
+	l.replaceStop(&l.stopHeartbeat, stop)
+	return stop
+}
+
+// stopHeartbeating stops the heartbeat started by StartHeartbeat, if any.
+func (l *Logger) stopHeartbeating() {
+	l.replaceStop(&l.stopHeartbeat, nil)
+}
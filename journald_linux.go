@@ -0,0 +1,123 @@
+//go:build linux
+
+package Logger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// journaldSocket is the socket of the systemd journal's native protocol.
+const journaldSocket = "/run/systemd/journal/socket"
+
+// journaldOutput sends entries to the systemd journal with the native protocol.
+type journaldOutput struct {
+	conn       *net.UnixConn
+	identifier string
+}
+
+// AddJournaldOutput sends every entry to the systemd journal as structured fields.
+// The message is written as MESSAGE, the level as the syslog PRIORITY (DEBUG=7,
+// INFO=6, WARNING=4, ERROR=3, FATAL=2), the program name as SYSLOG_IDENTIFIER and
+// the logger name, if any, as LOGGER.
+// Each attribute becomes a journal field named after its key in upper case, with
+// characters other than letters, digits and underscores replaced by "_", so
+// "user.id=42" can be queried with "journalctl USER_ID=42". Attributes named like
+// MESSAGE, PRIORITY or LOGGER do not override the entry's own fields. Entries larger than a
+// datagram are dropped. Only available on Linux.
+// Returns:
+// - An error if the journal socket cannot be reached, e.g. on systems without systemd.
+func (l *Logger) AddJournaldOutput() error {
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: journaldSocket, Net: "unixgram"})
+	if err != nil {
+		return err
+	}
+
+	l.addOutput(&journaldOutput{conn: conn, identifier: filepath.Base(os.Args[0])})
+	return nil
+}
+
+// writeEntry serializes the entry and sends it to the journal as one datagram.
+// Parameters:
+// - entry: The entry to send.
+// Returns:
+// - True if the journal accepted the datagram.
+func (o *journaldOutput) writeEntry(entry LogEntry) bool {
+	message := appendJournaldField(nil, "MESSAGE", []byte(entry.Message))
+	message = appendJournaldField(message, "PRIORITY", strconv.AppendInt(nil, int64(syslogSeverity(entry.Level)), 10))
+	message = appendJournaldField(message, "SYSLOG_IDENTIFIER", []byte(o.identifier))
+	if entry.Name != "" {
+		message = appendJournaldField(message, "LOGGER", []byte(entry.Name))
+	}
+	for _, attr := range entry.Attrs {
+		name := journaldFieldName(attr.Key)
+		if name == "MESSAGE" || name == "PRIORITY" || name == "SYSLOG_IDENTIFIER" || name == "LOGGER" {
+			continue
+		}
+		message = appendJournaldField(message, name, appendValue(nil, attr.Value))
+	}
+	_, err := o.conn.Write(message)
+	return err == nil
+}
+
+// close closes the connection to the journal.
+// Returns:
+// - An error if closing the connection fails.
+func (o *journaldOutput) close() error {
+	return o.conn.Close()
+}
+
+// appendJournaldField appends one field in the journal's native serialization.
+// Values without newlines are written as NAME=value; others use the binary form of
+// the name, a newline, the little-endian 64-bit length and the value.
+// Parameters:
+// - dst: The buffer to append to.
+// - name: The field name.
+// - value: The field value.
+// Returns:
+// - The extended buffer.
+func appendJournaldField(dst []byte, name string, value []byte) []byte {
+	dst = append(dst, name...)
+	if bytes.IndexByte(value, '\n') < 0 {
+		dst = append(dst, '=')
+		dst = append(dst, value...)
+		return append(dst, '\n')
+	}
+	dst = append(dst, '\n')
+	dst = binary.LittleEndian.AppendUint64(dst, uint64(len(value)))
+	dst = append(dst, value...)
+	return append(dst, '\n')
+}
+
+// journaldFieldName converts an attribute key into a valid journal field name.
+// Journal field names consist of upper-case letters, digits and underscores, may not
+// start with an underscore or a digit, and are at most 64 characters long.
+// Parameters:
+// - key: The attribute key.
+// Returns:
+// - The journal field name.
+func journaldFieldName(key string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, key)
+	name = strings.TrimLeft(name, "_")
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = "FIELD_" + name
+	}
+	if len(name) > 64 {
+		name = name[:64]
+	}
+	return name
+}
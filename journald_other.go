@@ -0,0 +1,12 @@
+//go:build !linux
+
+package Logger
+
+import "errors"
+
+// AddJournaldOutput is only available on Linux, where the systemd journal runs.
+// Returns:
+// - Always an error.
+func (l *Logger) AddJournaldOutput() error {
+	return errors.New("journald output is only supported on Linux")
+}
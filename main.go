@@ -1,11 +1,21 @@
 package Logger
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"log"
 	"os"
-	"time"
+	"reflect"
 	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/tabwriter"
+	"time"
+	"unicode/utf8"
 
 	"github.com/fatih/color"
 )
@@ -20,18 +30,174 @@ const (
 	WARNING
 	ERROR
 	FATAL
+	// OFF disables all logging. It suppresses every message, including the
+	// FATAL message itself, but Fatal still exits the program.
+	OFF
 )
 
+// String returns the name of the log level, e.g. "INFO".
+// Returns:
+// - The upper-case level name, or "LogLevel(n)" for unknown values.
+func (level LogLevel) String() string {
+	switch level {
+	case DEBUG:
+		return "DEBUG"
+	case INFO:
+		return "INFO"
+	case WARNING:
+		return "WARNING"
+	case ERROR:
+		return "ERROR"
+	case FATAL:
+		return "FATAL"
+	case OFF:
+		return "OFF"
+	}
+	return fmt.Sprintf("LogLevel(%d)", int(level))
+}
+
+// ParseLevel converts a level name such as "info" or "WARNING" into a LogLevel.
+// The name is matched case-insensitively.
+// Parameters:
+// - name: The level name (DEBUG, INFO, WARNING, ERROR, FATAL or OFF).
+// Returns:
+// - The matching LogLevel and an error if the name is unknown.
+func ParseLevel(name string) (LogLevel, error) {
+	for level := DEBUG; level <= OFF; level++ {
+		if strings.EqualFold(strings.TrimSpace(name), level.String()) {
+			return level, nil
+		}
+	}
+	return INFO, fmt.Errorf("unknown log level %q", name)
+}
+
+// EmptyMessagePolicy controls what happens to messages without any text.
+type EmptyMessagePolicy int
+
+// Defining the handling of empty messages
+const (
+	// EmptyMessageKeep writes empty messages as they are.
+	EmptyMessageKeep EmptyMessagePolicy = iota
+	// EmptyMessageSkip drops empty messages. FATAL messages are never dropped
+	// and are written with the placeholder instead.
+	EmptyMessageSkip
+	// EmptyMessagePlaceholder replaces empty messages with "(empty)".
+	EmptyMessagePlaceholder
+)
+
+// emptyPlaceholder replaces empty messages under EmptyMessagePlaceholder.
+const emptyPlaceholder = "(empty)"
+
+// truncatedMarker ends lines cut by SetMaxLineLength.
+const truncatedMarker = "... [truncated]"
+
+// timestampLayout is the time format used for the timestamp of each line.
+const timestampLayout = "2006-01-02 15:04:05"
+
+// Console colors, created once instead of on every message
+var (
+	timestampColor = color.New(color.FgWhite)
+	levelColors    = map[LogLevel]*color.Color{
+		DEBUG:   color.New(color.FgCyan),    // Cyan for DEBUG
+		INFO:    color.New(color.FgGreen),   // Green for INFO
+		WARNING: color.New(color.FgYellow),  // Yellow for WARNING
+		ERROR:   color.New(color.FgRed),     // Red for ERROR
+		FATAL:   color.New(color.FgMagenta), // Magenta for FATAL
+	}
+)
+
+// defaultLevelSymbols are the console symbols shown in emoji mode.
+var defaultLevelSymbols = [OFF]string{
+	DEBUG:   "🐛",
+	INFO:    "ℹ️",
+	WARNING: "⚠️",
+	ERROR:   "❌",
+	FATAL:   "💀",
+}
+
+// linePool holds reusable buffers for building log lines.
+var linePool = sync.Pool{
+	New: func() interface{} {
+		buffer := make([]byte, 0, 256)
+		return &buffer
+	},
+}
+
 // Logger struct holds the log level, file writer, console flag, and exit codes
 type Logger struct {
-	level        LogLevel
+	name         string
 	logFile      *os.File
 	logToConsole bool
-	ExitCodes    map[string]int
+	start        time.Time
+
+	// The settings below are atomic so they can be changed while other goroutines log
+	level          atomic.Int32
+	lineTerminator atomic.Pointer[string]
+	monotonic      atomic.Bool
+	delta          atomic.Bool
+	collapseSpace  atomic.Bool
+	emptyPolicy    atomic.Int32
+	environment    atomic.Pointer[string]
+	maxFields      atomic.Int64
+	maxLineLength  atomic.Int64
+	fatalDowngrade atomic.Bool
+	emojiMode      atomic.Bool
+	levelSymbols   atomic.Pointer[[OFF]string]
+	bellLevel      atomic.Int32
+	bellTTY        atomic.Bool
+	consoleWrap    atomic.Bool
+	consoleWidth   atomic.Int64
+	countLevel     atomic.Int32
+	closeSummary   atomic.Bool
+	failAllDown    atomic.Bool
+	downAttempts   atomic.Int64
+	downHandler    atomic.Pointer[func()]
+
+	onceKeys      sync.Map
+	counters      sync.Map
+	lineCounts    [OFF]atomic.Int64
+	bytesWritten  atomic.Int64
+	outputsDown   atomic.Int32
+	spillDropped  atomic.Int64
+	outputDropped atomic.Int64
+
+	// consoleMu guards the console buffer and serializes console writes
+	consoleMu     sync.Mutex
+	console       *bufio.Writer
+	consoleBroken atomic.Bool
+
+	// mu guards the fields below
+	mu              sync.Mutex
+	ownsFile        bool
+	exitCodes       map[string]int
+	statusLevelFunc func(int) LogLevel
+	crashLog        *os.File
+	pidFile         string
+	tempFile        string
+	stopAutoFlush   func()
+	stopHeartbeat   func()
+	channelOutputs  []*channelOutput
+	outputs         []entryOutput
+	sharedOutputs   []entryOutput
+	spillPath       string
+	spillMaxSize    int64
+	fieldProviders  []func() Fields
+	samplers        map[LogLevel]*adaptiveSampler
+	bursts          map[LogLevel]*burstSampler
+	encoders        map[reflect.Type]func(interface{}) interface{}
+	sensitiveKeys   []string
+	lastEntry       time.Time
+	muteUntil       time.Time
+	muteLevel       LogLevel
+	muteSuppressed  int
+	muteTimer       *time.Timer
+	muteGeneration  uint64
 }
 
 // NewLogger creates a new Logger instance with the provided log level and file path.
 // Automatically sets a finalizer to close the file when the logger is garbage collected.
+// If another logger in this process already has the file open, a warning is printed
+// and both loggers share the same file handle.
 // Parameters:
 // - level: The minimum log level the logger should display.
 // - logFilePath: The path to the log file.
@@ -39,22 +205,28 @@ type Logger struct {
 // Returns:
 // - A pointer to a Logger instance and an error if file creation fails.
 func NewLogger(level LogLevel, logFilePath string, logToConsole bool) (*Logger, error) {
-	file, err := os.OpenFile(logFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	file, err := openSharedFile(logFilePath)
 	if err != nil {
 		return nil, err
 	}
 
 	logger := &Logger{
-		level:        level,
 		logFile:      file,
+		ownsFile:     true,
+		start:        time.Now(),
 		logToConsole: logToConsole,
-		ExitCodes: map[string]int{
+		exitCodes: map[string]int{
 			"ERROR":    -1,
 			"SHUTDOWN": 0,
 			"SUCCESS":  0,
 		},
 	}
 
+	logger.level.Store(int32(level))
+	logger.SetLineTerminator("\n")
+	logger.bellLevel.Store(int32(OFF))
+	logger.countLevel.Store(int32(OFF))
+
 	// Automatically close the log file when the logger is garbage collected
 	runtime.SetFinalizer(logger, func(l *Logger) {
 		fmt.Println("Finalizer: Closing log file.")
@@ -66,71 +238,659 @@ func NewLogger(level LogLevel, logFilePath string, logToConsole bool) (*Logger,
 
 // Close closes the log file.
 // Should be called when logging is no longer needed.
+// It also writes the close summary (if enabled), flushes buffered console output,
+// stops auto flushing, the heartbeat and burst sampling, closes remote outputs, and
+// removes the crash log, the PID file and the log file of a NewTempLogger.
+// Loggers created with Derive do not close the log file they share with their parent,
+// and a file opened by several loggers is only closed when the last of them is closed.
 func (l *Logger) Close() {
-	if l.logFile != nil {
-		l.logFile.Close()
+	l.writeCloseSummary()
+	l.stopAutoFlushing()
+	l.stopHeartbeating()
+	l.stopBurstSampling()
+	l.stopMuteTimer()
+	l.closeOutputs()
+	l.flushConsole()
+	l.closeCrashLog(true)
+	l.removePIDFile()
+	l.mu.Lock()
+	release := l.logFile != nil && l.ownsFile
+	l.ownsFile = false
+	l.mu.Unlock()
+
+	if release {
+		releaseSharedFile(l.logFile)
+	}
+	l.removeTempFile()
+}
+
+// SetLevel changes the minimum log level the logger should display.
+// Setting the level to OFF suppresses all output, including FATAL messages
+// and the diagnostics printed before exiting. Fatal still terminates the
+// program with its exit code; only the output is silenced.
+// Parameters:
+// - level: The new minimum log level (DEBUG, INFO, WARNING, ERROR, FATAL or OFF).
+func (l *Logger) SetLevel(level LogLevel) {
+	l.level.Store(int32(level))
+}
+
+// currentLevel returns the minimum log level set with NewLogger or SetLevel.
+// Returns:
+// - The current minimum log level.
+func (l *Logger) currentLevel() LogLevel {
+	return LogLevel(l.level.Load())
+}
+
+// loadString returns the string stored in p, or "" if nothing was stored yet.
+// Parameters:
+// - p: The atomic string setting to read.
+// Returns:
+// - The stored string.
+func loadString(p *atomic.Pointer[string]) string {
+	if s := p.Load(); s != nil {
+		return *s
+	}
+	return ""
+}
+
+// SetExitCode registers or replaces a named exit code that can be passed to Fatal.
+// Parameters:
+// - name: The name of the exit code, e.g. "DB_INIT_ERROR".
+// - code: The process exit code to use.
+func (l *Logger) SetExitCode(name string, code int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.exitCodes[name] = code
+}
+
+// ExitCode looks up a named exit code.
+// Parameters:
+// - name: The name of the exit code.
+// Returns:
+// - The exit code and true if the name is registered, or 0 and false otherwise.
+func (l *Logger) ExitCode(name string) (int, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	code, exists := l.exitCodes[name]
+	return code, exists
+}
+
+// ExitCodes returns a copy of all registered exit codes.
+// Modifying the returned map does not affect the logger; use SetExitCode instead.
+// Returns:
+// - A map of exit code names to their values.
+func (l *Logger) ExitCodes() map[string]int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	codes := make(map[string]int, len(l.exitCodes))
+	for name, code := range l.exitCodes {
+		codes[name] = code
+	}
+	return codes
+}
+
+// DocumentExitCodes writes a table of the registered exit codes, for --help output or man pages.
+// Shell scripts that branch on $? can look up what each code means. The table has a
+// NAME and a CODE column and is sorted by code, then by name.
+// Parameters:
+// - w: The writer to write the table to, e.g. os.Stdout.
+func (l *Logger) DocumentExitCodes(w io.Writer) {
+	codes := l.ExitCodes()
+	names := make([]string, 0, len(codes))
+	for name := range codes {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if codes[names[i]] != codes[names[j]] {
+			return codes[names[i]] < codes[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	table := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, "NAME\tCODE")
+	for _, name := range names {
+		fmt.Fprintf(table, "%s\t%d\n", name, codes[name])
+	}
+	table.Flush()
+}
+
+// LevelForStatus maps an HTTP status code to a log level.
+// 2xx and 3xx map to INFO, 4xx to WARNING and 5xx to ERROR. Anything else maps to INFO.
+// Parameters:
+// - code: The HTTP status code.
+// Returns:
+// - The log level for the status code.
+func LevelForStatus(code int) LogLevel {
+	switch {
+	case code >= 500:
+		return ERROR
+	case code >= 400:
+		return WARNING
+	default:
+		return INFO
+	}
+}
+
+// SetStatusLevelFunc replaces the function used by StatusLevel to map HTTP status codes to log levels.
+// Passing nil restores the default LevelForStatus mapping.
+// Parameters:
+// - fn: The mapping function, e.g. one that treats 429 as ERROR.
+func (l *Logger) SetStatusLevelFunc(fn func(int) LogLevel) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.statusLevelFunc = fn
+}
+
+// StatusLevel returns the log level for an HTTP status code using the configured mapping.
+// Parameters:
+// - code: The HTTP status code.
+// Returns:
+// - The log level from the function set with SetStatusLevelFunc, or LevelForStatus by default.
+func (l *Logger) StatusLevel(code int) LogLevel {
+	l.mu.Lock()
+	fn := l.statusLevelFunc
+	l.mu.Unlock()
+
+	if fn == nil {
+		return LevelForStatus(code)
+	}
+	return fn(code)
+}
+
+// SetLineTerminator changes the line terminator used when writing to the log file.
+// The console output always uses "\n". Only "\n", "\r\n" and "\r" are accepted;
+// any other value is ignored and the current terminator is kept.
+// Parameters:
+// - terminator: The line terminator to append to each line in the log file.
+func (l *Logger) SetLineTerminator(terminator string) {
+	switch terminator {
+	case "\n", "\r\n", "\r":
+		l.lineTerminator.Store(&terminator)
+	default:
+		log.Printf("Invalid line terminator %q. Keeping %q.\n", terminator, loadString(&l.lineTerminator))
+	}
+}
+
+// EnvironmentKey is the attribute name used by SetEnvironment.
+const EnvironmentKey = "env"
+
+// SetEnvironment stamps every entry with the given environment, e.g. "prod".
+// The environment is added as the last attribute under EnvironmentKey ("env"),
+// unless the entry or a field provider already sets that key.
+// Parameters:
+// - env: The environment name. An empty string removes the field.
+func (l *Logger) SetEnvironment(env string) {
+	l.environment.Store(&env)
+}
+
+// SetEmptyMessagePolicy sets how messages without text are handled, e.g. Info() or Info("").
+// A message counts as empty if it only contains whitespace and has no attributes.
+// The default is EmptyMessageKeep.
+// Parameters:
+// - policy: EmptyMessageKeep, EmptyMessageSkip or EmptyMessagePlaceholder.
+func (l *Logger) SetEmptyMessagePolicy(policy EmptyMessagePolicy) {
+	l.emptyPolicy.Store(int32(policy))
+}
+
+// SetMonotonicTimestamps adds the time since the logger was created to each timestamp.
+// The offset is taken from the monotonic clock, e.g. "[2006-01-02 15:04:05 +12.345678s]",
+// so it keeps increasing in log order even when the wall clock is adjusted.
+// Parameters:
+// - enabled: Whether to include the monotonic offset.
+func (l *Logger) SetMonotonicTimestamps(enabled bool) {
+	l.monotonic.Store(enabled)
+}
+
+// SetDeltaTimestamps appends the time since the previous line to each line, e.g. "(+12ms)".
+// The first line after enabling it has no delta.
+// Parameters:
+// - enabled: Whether to append the delta.
+func (l *Logger) SetDeltaTimestamps(enabled bool) {
+	l.delta.Store(enabled)
+}
+
+// sinceLastEntry records now as the time of the latest entry and returns the time since the previous one.
+// Parameters:
+// - now: The time of the current entry.
+// Returns:
+// - The elapsed time, and false if there was no previous entry.
+func (l *Logger) sinceLastEntry(now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	previous := l.lastEntry
+	l.lastEntry = now
+	if previous.IsZero() {
+		return 0, false
+	}
+	return now.Sub(previous), true
+}
+
+// SetMaxFields limits the number of attributes written per entry.
+// Attributes beyond the limit are dropped, and the entry gets an extra
+// "fields_truncated=true" attribute. The limit counts all attributes, including
+// those from field providers and SetEnvironment.
+// Parameters:
+// - n: The maximum number of attributes per entry, or 0 for no limit.
+func (l *Logger) SetMaxFields(n int) {
+	l.maxFields.Store(int64(n))
+}
+
+// SetMaxLineLength caps the length of every rendered line, e.g. for log shippers
+// that reject lines over 64KB. A longer line is cut at a UTF-8 boundary and ends in
+// "... [truncated]", so the line including the marker has at most n bytes, not counting
+// the line terminator. The timestamp, level and name are never cut, so if n is too
+// small to hold them and the marker, the line keeps just those and exceeds n.
+// The cap applies to the file, console, crash log and global sinks, and to the
+// messages of the remote syslog output (see AddRemoteSyslogOutput). Channel and
+// journald outputs receive structured fields rather than lines and are not capped.
+// Parameters:
+// - n: The maximum line length in bytes, or 0 for no limit.
+func (l *Logger) SetMaxLineLength(n int) {
+	l.maxLineLength.Store(int64(n))
+}
+
+// SetFatalDowngrade turns FATAL messages into ERROR messages that do not exit.
+// When enabled, Fatal and FATAL-level messages are written with the ERROR level
+// and color, and the program keeps running. This is meant for library or test
+// contexts where exiting the process is not acceptable.
+// Parameters:
+// - downgrade: Whether to downgrade FATAL to ERROR.
+func (l *Logger) SetFatalDowngrade(downgrade bool) {
+	l.fatalDowngrade.Store(downgrade)
+}
+
+// SetEmojiMode prefixes console lines with a symbol for their level.
+// The defaults are 🐛 DEBUG, ℹ️ INFO, ⚠️ WARNING, ❌ ERROR and 💀 FATAL, and can be
+// changed with SetLevelSymbol. The log file never contains the symbols.
+// Parameters:
+// - enabled: Whether to show level symbols on the console.
+func (l *Logger) SetEmojiMode(enabled bool) {
+	l.emojiMode.Store(enabled)
+}
+
+// SetLevelSymbol changes the console symbol shown for a level in emoji mode.
+// Parameters:
+// - level: The log level (DEBUG to FATAL).
+// - symbol: The symbol to show, or "" to restore the default.
+func (l *Logger) SetLevelSymbol(level LogLevel, symbol string) {
+	if level < DEBUG || level > FATAL {
+		return
+	}
+	// Copy on write, retrying if another goroutine changed a symbol at the same time
+	for {
+		current := l.levelSymbols.Load()
+		symbols := new([OFF]string)
+		if current != nil {
+			*symbols = *current
+		}
+		symbols[level] = symbol
+		if l.levelSymbols.CompareAndSwap(current, symbols) {
+			return
+		}
+	}
+}
+
+// levelSymbol returns the console symbol for a level in emoji mode.
+// Parameters:
+// - level: The log level.
+// Returns:
+// - The custom symbol set with SetLevelSymbol, or the default one.
+func (l *Logger) levelSymbol(level LogLevel) string {
+	if symbols := l.levelSymbols.Load(); symbols != nil && symbols[level] != "" {
+		return symbols[level]
+	}
+	return defaultLevelSymbols[level]
+}
+
+// SetCollapseWhitespace enables or disables whitespace normalization of messages.
+// When enabled, runs of spaces and tabs are collapsed into a single space and
+// whitespace at the start and end of each line is removed. Newlines are kept as they are.
+// Parameters:
+// - collapse: Whether to normalize whitespace in messages.
+func (l *Logger) SetCollapseWhitespace(collapse bool) {
+	l.collapseSpace.Store(collapse)
+}
+
+// Mute suppresses messages below minLevel until the given time, then unmutes automatically.
+// An INFO line is logged when muting starts, and another one with the number of
+// suppressed messages is logged as soon as the mute ends, even if nothing else is logged.
+// Calling Mute again replaces the current mute window. Close cancels the mute.
+// Parameters:
+// - until: The time at which the mute ends.
+// - minLevel: Messages below this level are suppressed while muted.
+func (l *Logger) Mute(until time.Time, minLevel LogLevel) {
+	l.log(INFO, fmt.Sprintf("Muting messages below %s until %s.", minLevel, until.Format("2006-01-02 15:04:05")))
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.muteTimer != nil {
+		l.muteTimer.Stop()
 	}
+	l.muteUntil = until
+	l.muteLevel = minLevel
+	l.muteGeneration++
+	generation := l.muteGeneration
+	l.muteTimer = time.AfterFunc(time.Until(until), func() { l.endMute(generation) })
+}
+
+// endMute ends the mute window and logs how many messages were suppressed.
+// It does nothing if the mute has already ended, was replaced by another call to Mute
+// or was cancelled by Close.
+// Parameters:
+// - generation: The mute window to end, or 0 to end the current one.
+func (l *Logger) endMute(generation uint64) {
+	l.mu.Lock()
+	if l.muteUntil.IsZero() || (generation != 0 && generation != l.muteGeneration) {
+		l.mu.Unlock()
+		return
+	}
+	if l.muteTimer != nil {
+		l.muteTimer.Stop()
+		l.muteTimer = nil
+	}
+	suppressed := l.muteSuppressed
+	l.muteUntil = time.Time{}
+	l.muteSuppressed = 0
+	l.mu.Unlock()
+
+	l.log(INFO, fmt.Sprintf("Mute ended. Suppressed %d messages.", suppressed))
+}
+
+// stopMuteTimer cancels the timer that ends the mute started by Mute, if any.
+func (l *Logger) stopMuteTimer() {
+	l.mu.Lock()
+	if l.muteTimer != nil {
+		l.muteTimer.Stop()
+		l.muteTimer = nil
+	}
+	l.muteGeneration++
+	l.mu.Unlock()
+}
+
+// muted reports whether a message of the given level is suppressed by Mute.
+// If the mute window has expired but its timer has not fired yet, it ends the mute first.
+// Parameters:
+// - level: The log level of the message.
+// Returns:
+// - True if the message should be dropped.
+func (l *Logger) muted(level LogLevel) bool {
+	l.mu.Lock()
+	if l.muteUntil.IsZero() {
+		l.mu.Unlock()
+		return false
+	}
+
+	if time.Now().After(l.muteUntil) {
+		l.mu.Unlock()
+		l.endMute(0)
+		return false
+	}
+
+	if level < l.muteLevel {
+		l.muteSuppressed++
+		l.mu.Unlock()
+		return true
+	}
+
+	l.mu.Unlock()
+	return false
+}
+
+// enabled reports whether messages of the given level would be logged.
+// It is kept small enough to be inlined so that the variadic methods can
+// return before joining their arguments when the level is disabled.
+// Parameters:
+// - level: The log level to check.
+// Returns:
+// - True if the level is at or above the logger's minimum level.
+func (l *Logger) enabled(level LogLevel) bool {
+	return int32(level) >= l.level.Load()
 }
 
 // log is the core logging function. It prints log messages with a timestamp,
 // log level, and color (to console) according to the specified log level.
+// A FATAL message exits the program with the "ERROR" exit code, even if it was not
+// written because the level is OFF, unless FATAL is downgraded.
 // Parameters:
 // - level: The log level for the message (DEBUG, INFO, WARNING, ERROR, FATAL).
 // - msg: The log message to be displayed.
-func (l *Logger) log(level LogLevel, msg string) {
-	if level < l.level {
-		return
+// - attrs: Optional attributes appended to the message as key=value pairs.
+func (l *Logger) log(level LogLevel, msg string, attrs ...Attr) {
+	l.write(level, msg, attrs...)
+
+	// Exit if level is FATAL
+	if level == FATAL && !l.fatalDowngrade.Load() {
+		exitCode, _ := l.ExitCode("ERROR")
+		l.handleFatal(exitCode)
 	}
+}
 
-	var levelString string
-	var levelColor *color.Color
+// write formats a log message and writes it to all outputs, without exiting on FATAL.
+// Parameters:
+// - level: The log level for the message (DEBUG, INFO, WARNING, ERROR, FATAL).
+// - msg: The log message to be displayed.
+// - attrs: Optional attributes appended to the message as key=value pairs.
+// Returns:
+// - True if the message was written, false if it was filtered out or the level is invalid.
+func (l *Logger) write(level LogLevel, msg string, attrs ...Attr) bool {
+	if level < DEBUG || level >= OFF {
+		// OFF is a threshold, not a level a message can have
+		return false
+	}
+	// Check the level before downgrading, so a downgraded FATAL passes a FATAL threshold
+	if !l.enabled(level) {
+		return false
+	}
+	if level == FATAL && l.fatalDowngrade.Load() {
+		level = ERROR
+	}
 
-	// Assign the appropriate color for the log level
-	switch level {
-	case DEBUG:
-		levelString = "DEBUG"
-		levelColor = color.New(color.FgCyan) // Cyan for DEBUG
-	case INFO:
-		levelString = "INFO"
-		levelColor = color.New(color.FgGreen) // Green for INFO
-	case WARNING:
-		levelString = "WARNING"
-		levelColor = color.New(color.FgYellow) // Yellow for WARNING
-	case ERROR:
-		levelString = "ERROR"
-		levelColor = color.New(color.FgRed) // Red for ERROR
-	case FATAL:
-		levelString = "FATAL"
-		levelColor = color.New(color.FgMagenta) // Magenta for FATAL
+	if l.muted(level) || !l.sampled(level) || !l.burstSampled(level, msg) {
+		return false
+	}
+
+	if policy := EmptyMessagePolicy(l.emptyPolicy.Load()); policy != EmptyMessageKeep && len(attrs) == 0 && strings.TrimSpace(msg) == "" {
+		if policy == EmptyMessageSkip && level != FATAL {
+			return false
+		}
+		msg = emptyPlaceholder
+	}
+
+	l.emit(level, msg, attrs)
+	return true
+}
+
+// emit formats a message that passed the filters of write and writes it to all outputs.
+// Parameters:
+// - level: The log level for the message (DEBUG, INFO, WARNING, ERROR, FATAL).
+// - msg: The log message to be displayed.
+// - attrs: Attributes appended to the message as key=value pairs.
+func (l *Logger) emit(level LogLevel, msg string, attrs []Attr) {
+	attrs = l.withProviderFields(attrs)
+	if environment := loadString(&l.environment); environment != "" && !hasAttr(attrs, EnvironmentKey) {
+		// Cap the capacity so the caller's slice is never written to
+		attrs = append(attrs[:len(attrs):len(attrs)], String(EnvironmentKey, environment))
+	}
+	if maxFields := int(l.maxFields.Load()); maxFields > 0 && len(attrs) > maxFields {
+		attrs = append(attrs[:maxFields:maxFields], Bool("fields_truncated", true))
+	}
+	attrs = l.encodeAttrs(attrs)
+	attrs = l.maskAttrs(attrs)
+
+	if l.collapseSpace.Load() {
+		msg = collapseWhitespace(msg)
 	}
 
-	timestamp := time.Now().Format("2006-01-02 15:04:05")
-	logLine := fmt.Sprintf("[%s] %s: %s\n", timestamp, levelString, msg)
+	levelString := level.String()
+	levelColor := levelColors[level]
+
+	// Build the file line in a pooled buffer to avoid allocating per message
+	buffer := linePool.Get().(*[]byte)
+	defer linePool.Put(buffer)
+
+	now := time.Now()
+	logLine := append((*buffer)[:0], '[')
+	logLine = now.AppendFormat(logLine, timestampLayout)
+	if l.monotonic.Load() {
+		// time.Time.Sub uses the monotonic clock, which never jumps with NTP adjustments
+		logLine = append(logLine, " +"...)
+		logLine = strconv.AppendFloat(logLine, now.Sub(l.start).Seconds(), 'f', 6, 64)
+		logLine = append(logLine, 's')
+	}
+	timestampEnd := len(logLine)
+	logLine = append(logLine, "] "...)
+	logLine = append(logLine, levelString...)
+	logLine = append(logLine, ": "...)
+	textStart := len(logLine)
+	if l.name != "" {
+		logLine = append(logLine, '[')
+		logLine = append(logLine, l.name...)
+		logLine = append(logLine, "] "...)
+	}
+	nameEnd := len(logLine)
+	logLine = appendMessage(logLine, msg)
+	if len(attrs) > 0 {
+		if msg != "" {
+			logLine = append(logLine, ' ')
+		}
+		logLine = appendAttrs(logLine, attrs)
+	}
+	if l.delta.Load() {
+		if elapsed, ok := l.sinceLastEntry(now); ok {
+			if elapsed < time.Millisecond {
+				elapsed = elapsed.Round(time.Microsecond)
+			} else {
+				elapsed = elapsed.Round(time.Millisecond)
+			}
+			if logLine[len(logLine)-1] != ' ' {
+				logLine = append(logLine, ' ')
+			}
+			logLine = append(logLine, "(+"...)
+			logLine = append(logLine, elapsed.String()...)
+			logLine = append(logLine, ')')
+		}
+	}
+	if maxLength := int(l.maxLineLength.Load()); maxLength > 0 && len(logLine) > maxLength {
+		logLine = truncateLine(logLine, maxLength, nameEnd)
+	}
+	textEnd := len(logLine)
+	logLine = append(logLine, loadString(&l.lineTerminator)...)
+	*buffer = logLine
 
 	// Write to file (without color)
+	var fileErr error
+	written := 0
 	if l.logFile != nil {
-		l.logFile.WriteString(logLine)
+		written, fileErr = l.logFile.Write(logLine)
 	}
+	l.countLine(level, written)
+
+	// Keep the latest line durable in the crash log
+	l.writeCrashLog(logLine)
+
+	// Forward to the sinks shared by all loggers
+	writeGlobalSinks(level, logLine)
+
+	// Hand the typed entry to in-process consumers and structured outputs
+	entry := LogEntry{Time: now, Level: level, Name: l.name, Message: msg, Attrs: attrs}
+	channels, channelsFailed := l.writeChannelOutputs(entry)
+	outputs, outputsFailed := l.writeOutputs(entry)
 
 	// Print to console (with color)
-	if l.logToConsole {
-		fmt.Printf("[%s] %s: %s\n",
-			color.New(color.FgWhite).Sprint(timestamp),
-			levelColor.Sprint(levelString),
-			msg,
-		)
+	var consoleErr error
+	if l.logToConsole && !l.consoleBroken.Load() {
+		text := string(logLine[textStart:textEnd])
+
+		// Build the whole console line first so it reaches the terminal in a single write
+		consoleBuffer := linePool.Get().(*[]byte)
+		consoleLine := (*consoleBuffer)[:0]
+		emojiMode := l.emojiMode.Load()
+		if emojiMode {
+			consoleLine = append(consoleLine, l.levelSymbol(level)...)
+			consoleLine = append(consoleLine, ' ')
+		}
+		consoleLine = append(consoleLine, '[')
+		consoleLine = append(consoleLine, timestampColor.Sprint(string(logLine[1:timestampEnd]))...)
+		consoleLine = append(consoleLine, "] "...)
+		consoleLine = append(consoleLine, levelColor.Sprint(levelString)...)
+		consoleLine = append(consoleLine, ": "...)
+		if l.consoleWrap.Load() {
+			// Continuation lines start under the message, after "[timestamp] LEVEL: "
+			indent := timestampEnd + len("] ") + len(levelString) + len(": ")
+			if emojiMode {
+				indent += emojiColumns + 1
+			}
+			consoleLine = append(consoleLine, wrapText(text, l.wrapWidth(), indent)...)
+		} else {
+			consoleLine = append(consoleLine, text...)
+		}
+		consoleLine = append(consoleLine, '\n')
+
+		l.consoleMu.Lock()
+		_, consoleErr = l.consoleWriter().Write(consoleLine)
+		l.consoleMu.Unlock()
+		l.handleConsoleError(consoleErr)
+
+		*consoleBuffer = consoleLine
+		linePool.Put(consoleBuffer)
+		l.ringBell(level, text)
 	}
 
-	// Exit if level is FATAL
-	if level == FATAL {
-		l.handleFatal(l.ExitCodes["ERROR"])
+	if l.failAllDown.Load() {
+		l.checkOutputsDown(fileErr, consoleErr, channels+outputs, channelsFailed+outputsFailed)
+	}
+}
+
+// LogLevel logs a single, already assembled message at the given level.
+// This is the performance-oriented entry point: it skips the variadic arguments and
+// the join of the level methods, but still applies level filtering, formatting and
+// all outputs. Logging at FATAL exits the program with the "ERROR" exit code.
+// OFF and unknown levels are rejected with a warning, like LogString rejects OFF.
+// Parameters:
+// - level: The log level for the message (DEBUG, INFO, WARNING, ERROR, FATAL).
+// - msg: The log message to be displayed, written as is.
+func (l *Logger) LogLevel(level LogLevel, msg string) {
+	if level < DEBUG || level >= OFF {
+		if l.currentLevel() != OFF {
+			log.Printf("Cannot log at level %s. The message is dropped.\n", level)
+		}
+		return
+	}
+	if level != FATAL && !l.enabled(level) {
+		return
+	}
+	l.log(level, msg)
+}
+
+// LogString logs a message at a level given by name, e.g. when replaying stored events.
+// The name is parsed with ParseLevel. A FATAL level exits the program like LogLevel does.
+// Parameters:
+// - levelName: The level name, e.g. "warning".
+// - msg: The log message to be displayed.
+// Returns:
+// - An error if the level name is unknown or OFF; nothing is logged in that case.
+func (l *Logger) LogString(levelName string, msg ...string) error {
+	level, err := ParseLevel(levelName)
+	if err != nil {
+		return err
+	}
+	if level == OFF {
+		return fmt.Errorf("cannot log at level %s", level)
+	}
+	if level == FATAL || l.enabled(level) {
+		l.log(level, join(msg))
 	}
+	return nil
 }
 
 // Info logs a message with INFO level.
 // Parameters:
 // - msg: The log message to be displayed.
 func (l *Logger) Info(msg ...string) {
+	if !l.enabled(INFO) {
+		return
+	}
 	l.log(INFO, join(msg))
 }
 
@@ -138,6 +898,9 @@ func (l *Logger) Info(msg ...string) {
 // Parameters:
 // - msg: The log message to be displayed.
 func (l *Logger) Warning(msg ...string) {
+	if !l.enabled(WARNING) {
+		return
+	}
 	l.log(WARNING, join(msg))
 }
 
@@ -145,6 +908,9 @@ func (l *Logger) Warning(msg ...string) {
 // Parameters:
 // - msg: The log message to be displayed.
 func (l *Logger) Debug(msg ...string) {
+	if !l.enabled(DEBUG) {
+		return
+	}
 	l.log(DEBUG, join(msg))
 }
 
@@ -152,37 +918,84 @@ func (l *Logger) Debug(msg ...string) {
 // Parameters:
 // - msg: The log message to be displayed.
 func (l *Logger) Error(msg ...string) {
+	if !l.enabled(ERROR) {
+		return
+	}
 	l.log(ERROR, join(msg))
 }
 
+// Event logs a named event with typed attributes at INFO level.
+// The event name is written as the "event" attribute, followed by the given attributes.
+// Parameters:
+// - name: The name of the event.
+// - attrs: Typed attributes describing the event (see String, Int, Bool, Float and Time).
+func (l *Logger) Event(name string, attrs ...Attr) {
+	if !l.enabled(INFO) {
+		return
+	}
+	l.log(INFO, "", append([]Attr{String("event", name)}, attrs...)...)
+}
+
 // Fatal logs a message with FATAL level and exits the program with the corresponding exit code.
 // Parameters:
-// - exitCodeName: The name of the exit code to be used from the ExitCodes map.
+// - exitCodeName: The name of the exit code to be used, as registered with SetExitCode.
 // - msg: The log message to be displayed.
 func (l *Logger) Fatal(exitCodeName string, msg ...string) {
-	message := join(msg)
-	l.log(FATAL, message)
+	if l.enabled(FATAL) {
+		l.write(FATAL, join(msg))
+	}
+
+	// A downgraded FATAL was logged as ERROR and must not exit
+	if l.fatalDowngrade.Load() {
+		return
+	}
 
 	// Fetch the exit code from the map by its name
-	exitCode, exists := l.ExitCodes[exitCodeName]
+	exitCode, exists := l.ExitCode(exitCodeName)
 	if !exists {
 		// If the exit code name is not valid, use "SUCCESS" (0) as a fallback
-		log.Printf("Invalid exit code name. Defaulting to 'SUCCESS' (0).\n")
-		exitCode = l.ExitCodes["SUCCESS"]
+		if l.currentLevel() != OFF {
+			log.Printf("Invalid exit code name. Defaulting to 'SUCCESS' (0).\n")
+		}
+		exitCode, _ = l.ExitCode("SUCCESS")
 	}
 
 	// Handle fatal error by exiting the program with the specified exit code
 	l.handleFatal(exitCode)
 }
 
+// FatalCode logs a message with FATAL level and exits the program with the given exit code.
+// Unlike Fatal, the code does not need to be registered with SetExitCode, which suits
+// codes computed at runtime, e.g. when propagating the exit status of a subprocess.
+// Parameters:
+// - code: The exit code to exit the program with.
+// - msg: The message to be logged.
+func (l *Logger) FatalCode(code int, msg ...string) {
+	if l.enabled(FATAL) {
+		l.write(FATAL, join(msg))
+	}
+
+	// A downgraded FATAL was logged as ERROR and must not exit
+	if l.fatalDowngrade.Load() {
+		return
+	}
+
+	l.handleFatal(code)
+}
+
 // handleFatal is responsible for handling fatal errors. It performs any necessary cleanup
 // and then exits the program using the specified exit code.
 // Parameters:
 // - exitCode: The exit code to be used when exiting the program.
 func (l *Logger) handleFatal(exitCode int) {
-	log.Println("A fatal error occurred. Exiting...")
+	// Logging is disabled entirely when the level is OFF, but the exit still happens
+	if l.currentLevel() != OFF {
+		log.Println("A fatal error occurred. Exiting...")
+	}
+	// Keep the crash log so the FATAL line survives for inspection
+	l.closeCrashLog(false)
 	l.Close()
-	os.Exit(exitCode)
+	exit(exitCode)
 }
 
 // join joins multiple strings with spaces.
@@ -191,9 +1004,50 @@ func (l *Logger) handleFatal(exitCode int) {
 // Returns:
 // - A single string with all parts separated by spaces.
 func join(parts []string) string {
-	result := ""
+	size := 0
+	for _, part := range parts {
+		size += len(part) + 1
+	}
+
+	var result strings.Builder
+	result.Grow(size)
 	for _, part := range parts {
-		result += part + " "
+		result.WriteString(part)
+		result.WriteByte(' ')
+	}
+	return result.String()
+}
+
+// truncateLine cuts a rendered line to the maximum length and appends the truncation marker.
+// Parameters:
+// - line: The rendered line without the line terminator.
+// - max: The maximum length in bytes, including the marker.
+// - keep: The length of the prefix that is never cut.
+// Returns:
+// - The truncated line.
+func truncateLine(line []byte, max, keep int) []byte {
+	cut := max - len(truncatedMarker)
+	if cut < keep {
+		cut = keep
+	}
+	for cut > keep && !utf8.RuneStart(line[cut]) {
+		cut--
+	}
+	return append(line[:cut], truncatedMarker...)
+}
+
+// collapseWhitespace collapses runs of spaces and tabs into single spaces and
+// trims them from the start and end of every line, leaving newlines untouched.
+// Parameters:
+// - s: The string to normalize.
+// Returns:
+// - The normalized string.
+func collapseWhitespace(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.Join(strings.FieldsFunc(line, func(r rune) bool {
+			return r == ' ' || r == '\t'
+		}), " ")
 	}
-	return result
+	return strings.Join(lines, "\n")
 }
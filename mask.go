@@ -0,0 +1,71 @@
+package Logger
+
+import (
+	"path"
+	"strings"
+)
+
+// maskedValue replaces the values of sensitive attributes.
+const maskedValue = "***"
+
+// SetSensitiveKeys masks the values of attributes whose key matches one of the patterns.
+// Matching is case-insensitive, and patterns may use wildcards as in path.Match, e.g.
+// "*_secret" or "token*". Masked values are written as "***" to every output,
+// including channel outputs. Calling SetSensitiveKeys again replaces the previous keys.
+// Parameters:
+// - keys: The attribute keys or patterns to mask, e.g. "password", "token", "*_secret".
+func (l *Logger) SetSensitiveKeys(keys ...string) {
+	patterns := make([]string, len(keys))
+	for i, key := range keys {
+		patterns[i] = strings.ToLower(key)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sensitiveKeys = patterns
+}
+
+// maskAttrs replaces the values of sensitive attributes with "***".
+// Parameters:
+// - attrs: The attributes to mask. The slice is not modified.
+// Returns:
+// - The masked attributes, or attrs itself if nothing was masked.
+func (l *Logger) maskAttrs(attrs []Attr) []Attr {
+	l.mu.Lock()
+	patterns := l.sensitiveKeys
+	l.mu.Unlock()
+
+	if len(patterns) == 0 {
+		return attrs
+	}
+
+	var masked []Attr
+	for i, attr := range attrs {
+		if !matchesAny(strings.ToLower(attr.Key), patterns) {
+			continue
+		}
+		if masked == nil {
+			masked = append([]Attr(nil), attrs...)
+		}
+		masked[i].Value = maskedValue
+	}
+	if masked == nil {
+		return attrs
+	}
+	return masked
+}
+
+// matchesAny reports whether the key matches one of the patterns.
+// Parameters:
+// - key: The lower-case attribute key.
+// - patterns: The lower-case patterns.
+// Returns:
+// - True if any pattern matches.
+func matchesAny(key string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, key); matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package Logger
+
+import "sort"
+
+// LogMetrics logs a snapshot of a metric group at INFO level as a single entry.
+// The entry carries a "metric" attribute naming the group, followed by one attribute
+// per value sorted by key, e.g. "metric=http requests=120 p99_ms=41.5". Values stay
+// float64 attributes, so channel outputs receive them as numbers.
+// Parameters:
+// - name: The name of the metric group, e.g. "http".
+// - values: The metric values by name.
+func (l *Logger) LogMetrics(name string, values map[string]float64) {
+	if !l.enabled(INFO) {
+		return
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]Attr, 0, len(keys)+1)
+	attrs = append(attrs, String("metric", name))
+	for _, key := range keys {
+		attrs = append(attrs, Float(key, values[key]))
+	}
+	l.log(INFO, "", attrs...)
+}
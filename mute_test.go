@@ -0,0 +1,40 @@
+package Logger
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMuteEndsWithoutFurtherMessages(t *testing.T) {
+	l, path := newTestLogger(t, INFO)
+	l.Mute(time.Now().Add(50*time.Millisecond), ERROR)
+	l.Info("suppressed")
+	time.Sleep(150 * time.Millisecond)
+
+	if got := readLog(t, path); !strings.Contains(got, "Mute ended. Suppressed 1 messages.") {
+		t.Fatalf("mute end line missing:\n%s", got)
+	}
+}
+
+func TestMuteReplacedDoesNotEndEarly(t *testing.T) {
+	l, path := newTestLogger(t, INFO)
+	l.Mute(time.Now().Add(50*time.Millisecond), ERROR)
+	l.Mute(time.Now().Add(time.Hour), ERROR)
+	time.Sleep(150 * time.Millisecond)
+
+	if got := readLog(t, path); strings.Contains(got, "Mute ended") {
+		t.Fatalf("replaced mute ended early:\n%s", got)
+	}
+}
+
+func TestCloseStopsMuteTimer(t *testing.T) {
+	l, path := newTestLogger(t, INFO)
+	l.Mute(time.Now().Add(50*time.Millisecond), ERROR)
+	l.Close()
+	time.Sleep(150 * time.Millisecond)
+
+	if got := readLog(t, path); strings.Contains(got, "Mute ended") {
+		t.Fatalf("mute ended after Close:\n%s", got)
+	}
+}
@@ -0,0 +1,20 @@
+//go:build notify
+
+package Logger
+
+import "os/exec"
+
+// notify shows a desktop notification using notify-send.
+// Only compiled with the "notify" build tag. Errors, such as notify-send
+// not being installed, are ignored.
+// Parameters:
+// - level: The log level of the message, used as the notification title.
+// - msg: The log message, used as the notification body.
+func notify(level LogLevel, msg string) {
+	cmd := exec.Command("notify-send", level.String(), msg)
+	if err := cmd.Start(); err != nil {
+		return
+	}
+	// Reap the process so it does not linger as a zombie
+	go cmd.Wait()
+}
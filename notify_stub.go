@@ -0,0 +1,9 @@
+//go:build !notify
+
+package Logger
+
+// notify is a no-op unless the package is built with the "notify" build tag.
+// Parameters:
+// - level: The log level of the message.
+// - msg: The log message.
+func notify(level LogLevel, msg string) {}
@@ -0,0 +1,55 @@
+package Logger
+
+// logOnce logs a message the first time the key is seen and skips it afterwards.
+// A key is only marked as seen when the message is actually logged, so a message
+// dropped because its level is disabled, the logger is muted or it was sampled out
+// can still be logged later.
+// Parameters:
+// - level: The log level for the message.
+// - key: The key identifying the message, e.g. "deprecated-config-v1".
+// - msg: The log message parts.
+func (l *Logger) logOnce(level LogLevel, key string, msg []string) {
+	if !l.enabled(level) {
+		return
+	}
+	if _, seen := l.onceKeys.LoadOrStore(key, struct{}{}); seen {
+		return
+	}
+	if !l.write(level, join(msg)) {
+		// Dropped by mute, sampling or the empty message policy: release the key
+		l.onceKeys.Delete(key)
+	}
+}
+
+// DebugOnce logs a message with DEBUG level only the first time the key is used.
+// Parameters:
+// - key: The key identifying the message.
+// - msg: The log message to be displayed.
+func (l *Logger) DebugOnce(key string, msg ...string) {
+	l.logOnce(DEBUG, key, msg)
+}
+
+// InfoOnce logs a message with INFO level only the first time the key is used.
+// Parameters:
+// - key: The key identifying the message.
+// - msg: The log message to be displayed.
+func (l *Logger) InfoOnce(key string, msg ...string) {
+	l.logOnce(INFO, key, msg)
+}
+
+// WarningOnce logs a message with WARNING level only the first time the key is used.
+// This is intended for deprecation or configuration notices that would otherwise repeat.
+// Parameters:
+// - key: The key identifying the message.
+// - msg: The log message to be displayed.
+func (l *Logger) WarningOnce(key string, msg ...string) {
+	l.logOnce(WARNING, key, msg)
+}
+
+// ErrorOnce logs a message with ERROR level only the first time the key is used.
+// Parameters:
+// - key: The key identifying the message.
+// - msg: The log message to be displayed.
+func (l *Logger) ErrorOnce(key string, msg ...string) {
+	l.logOnce(ERROR, key, msg)
+}
@@ -0,0 +1,24 @@
+package Logger
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOnceKeyKeptForDroppedMessage(t *testing.T) {
+	l, path := newTestLogger(t, INFO)
+	l.Mute(time.Now().Add(50*time.Millisecond), ERROR)
+	l.WarningOnce("deprecated", "muted notice")
+	time.Sleep(100 * time.Millisecond)
+	l.WarningOnce("deprecated", "notice")
+	l.WarningOnce("deprecated", "notice")
+
+	got := readLog(t, path)
+	if strings.Contains(got, "muted notice") {
+		t.Fatalf("muted message was written:\n%s", got)
+	}
+	if n := strings.Count(got, "WARNING: notice"); n != 1 {
+		t.Fatalf("notice written %d times, want 1:\n%s", n, got)
+	}
+}
@@ -0,0 +1,62 @@
+package Logger
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// Op is a running operation started with Logger.Operation.
+type Op struct {
+	logger *Logger
+	name   string
+	id     string
+	start  time.Time
+}
+
+// Operation logs the start of an operation and returns it so that its end can be logged with Done.
+// Both entries carry the operation name and a random operation ID, which ties the
+// "started" and "completed"/"failed" lines together even when they are far apart.
+// Parameters:
+// - name: The name of the operation, e.g. "import-users".
+// Returns:
+// - A pointer to the running Op.
+func (l *Logger) Operation(name string) *Op {
+	op := &Op{logger: l, name: name, id: newOperationID(), start: time.Now()}
+	l.log(INFO, "Operation started", String("operation", op.name), String("op_id", op.id))
+	return op
+}
+
+// ID returns the operation ID shared by the start and end entries.
+// Returns:
+// - The operation ID.
+func (op *Op) ID() string {
+	return op.id
+}
+
+// Done logs the end of the operation with its duration.
+// A nil error is logged as "Operation completed" at INFO level, anything else
+// as "Operation failed" at ERROR level with an "error" attribute.
+// Parameters:
+// - err: The result of the operation.
+func (op *Op) Done(err error) {
+	attrs := []Attr{
+		String("operation", op.name),
+		String("op_id", op.id),
+		String("duration", time.Since(op.start).String()),
+	}
+	if err != nil {
+		op.logger.log(ERROR, "Operation failed", append(attrs, String("error", err.Error()))...)
+		return
+	}
+	op.logger.log(INFO, "Operation completed", attrs...)
+}
+
+// newOperationID generates a short random ID for correlating operation entries.
+// Returns:
+// - 16 hexadecimal characters.
+func newOperationID() string {
+	id := make([]byte, 8)
+	rand.Read(id)
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,56 @@
+package Logger
+
+// entryOutput is an output that receives every logged entry in structured form,
+// such as a remote syslog server.
+type entryOutput interface {
+	// writeEntry delivers one entry. Errors are handled by the output itself;
+	// the result only reports whether the entry was accepted by a working output.
+	writeEntry(entry LogEntry) bool
+	// close releases the output's resources.
+	close() error
+}
+
+// addOutput registers an entry output.
+// Parameters:
+// - output: The output to add.
+func (l *Logger) addOutput(output entryOutput) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.outputs = append(l.outputs, output)
+}
+
+// writeOutputs delivers an entry to all entry outputs.
+// Parameters:
+// - entry: The entry to deliver.
+// Returns:
+// - The number of entry outputs and how many of them failed to accept the entry.
+func (l *Logger) writeOutputs(entry LogEntry) (outputs, failed int) {
+	l.mu.Lock()
+	entryOutputs, sharedOutputs := l.outputs, l.sharedOutputs
+	l.mu.Unlock()
+
+	for _, output := range entryOutputs {
+		if !output.writeEntry(entry) {
+			failed++
+		}
+	}
+	// Outputs inherited through Derive are written to, but closed by the parent
+	for _, output := range sharedOutputs {
+		if !output.writeEntry(entry) {
+			failed++
+		}
+	}
+	return len(entryOutputs) + len(sharedOutputs), failed
+}
+
+// closeOutputs closes and removes all entry outputs.
+func (l *Logger) closeOutputs() {
+	l.mu.Lock()
+	outputs := l.outputs
+	l.outputs = nil
+	l.mu.Unlock()
+
+	for _, output := range outputs {
+		output.close()
+	}
+}
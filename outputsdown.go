@@ -0,0 +1,84 @@
+package Logger
+
+import (
+	"errors"
+	"os"
+	"strconv"
+)
+
+// defaultDownAttempts is the number of consecutive failed entries after which all
+// outputs are considered down, unless set with SetOutputsDownHandler.
+const defaultDownAttempts = 3
+
+// SetFailOnAllOutputsDown makes the logger fail fast when it can no longer write anywhere.
+// When enabled and every output of the logger fails for a number of consecutive entries,
+// the handler set with SetOutputsDownHandler is called, or, if none is set, a FATAL message
+// is logged and the program exits with the "ERROR" exit code. The outputs are the file,
+// the console, channel outputs (a non-blocking channel that drops the entry has failed)
+// and remote outputs such as syslog (failed while they cannot reach their server). Global
+// sinks are shared by all loggers and not considered. This keeps a service from running
+// on without any observability. Entries logged after Close, or by a derived logger after
+// its parent was closed, never count as failed. It is disabled by default.
+// Parameters:
+// - fail: Whether to fail when all outputs are down.
+func (l *Logger) SetFailOnAllOutputsDown(fail bool) {
+	l.failAllDown.Store(fail)
+	l.outputsDown.Store(0)
+}
+
+// SetOutputsDownHandler configures what happens when all outputs are down.
+// It only takes effect when SetFailOnAllOutputsDown is enabled.
+// Parameters:
+// - attempts: The number of consecutive failed entries before all outputs count as down.
+// Values below 1 restore the default of 3.
+// - handler: Called once when the outputs go down, instead of exiting the program.
+// A nil handler restores the default FATAL exit.
+func (l *Logger) SetOutputsDownHandler(attempts int, handler func()) {
+	if attempts < 1 {
+		attempts = defaultDownAttempts
+	}
+	l.downAttempts.Store(int64(attempts))
+	if handler == nil {
+		l.downHandler.Store(nil)
+	} else {
+		l.downHandler.Store(&handler)
+	}
+}
+
+// checkOutputsDown counts entries that reached no output and fails when the threshold is hit.
+// The counter is reset by the first entry that is written somewhere again.
+// Parameters:
+// - fileErr: The error from writing the entry to the file, if any.
+// - consoleErr: The error from writing the entry to the console, if any.
+// - outputs: The number of channel and entry outputs the entry was handed to.
+// - failed: How many of those outputs dropped the entry or are down.
+func (l *Logger) checkOutputsDown(fileErr, consoleErr error, outputs, failed int) {
+	if l.logFile == nil && !l.logToConsole && outputs == 0 {
+		return
+	}
+	if errors.Is(fileErr, os.ErrClosed) {
+		// The logger (or the parent it was derived from) is closed; this is shutdown, not an outage
+		return
+	}
+	fileDown := l.logFile == nil || fileErr != nil
+	consoleDown := !l.logToConsole || consoleErr != nil || l.consoleBroken.Load()
+	if !fileDown || !consoleDown || failed < outputs {
+		l.outputsDown.Store(0)
+		return
+	}
+
+	attempts := int(l.downAttempts.Load())
+	if attempts < 1 {
+		attempts = defaultDownAttempts
+	}
+	// Only the entry that reaches the threshold fails, so the FATAL below cannot recurse
+	if int(l.outputsDown.Add(1)) != attempts {
+		return
+	}
+
+	if handler := l.downHandler.Load(); handler != nil {
+		(*handler)()
+		return
+	}
+	l.log(FATAL, "All log outputs failed "+strconv.Itoa(attempts)+" consecutive times. Exiting.")
+}
@@ -0,0 +1,22 @@
+package Logger
+
+import "testing"
+
+func TestOutputsDownIgnoresClosedLogger(t *testing.T) {
+	l, _ := newTestLogger(t, INFO)
+	child := l.Derive("child")
+	l.SetFailOnAllOutputsDown(true)
+	child.SetFailOnAllOutputsDown(true)
+	called := 0
+	l.SetOutputsDownHandler(1, func() { called++ })
+	child.SetOutputsDownHandler(1, func() { called++ })
+	l.Close()
+
+	for i := 0; i < 3; i++ {
+		l.Info("late shutdown line")
+		child.Info("child after parent close")
+	}
+	if called != 0 {
+		t.Fatalf("outputs down handler called %d times after Close", called)
+	}
+}
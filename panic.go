@@ -0,0 +1,56 @@
+package Logger
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LogPanic logs a value recovered from a panic at ERROR level.
+// It is meant for code that calls recover() itself and wants the panic logged
+// in a standard shape: the message is "panic: <value>", followed by a "panic_type"
+// attribute and a "stack" attribute holding one entry per stack frame.
+// Parameters:
+// - recovered: The value returned by recover(). Errors, strings and other values are all accepted.
+// - stack: The stack trace, usually from debug.Stack(). May be nil.
+func (l *Logger) LogPanic(recovered interface{}, stack []byte) {
+	if !l.enabled(ERROR) {
+		return
+	}
+
+	var value string
+	switch v := recovered.(type) {
+	case error:
+		value = v.Error()
+	case string:
+		value = v
+	default:
+		value = fmt.Sprintf("%v", v)
+	}
+
+	attrs := []Attr{String("panic_type", fmt.Sprintf("%T", recovered))}
+	if frames := stackFrames(stack); len(frames) > 0 {
+		attrs = append(attrs, Attr{Key: "stack", Value: frames})
+	}
+	l.log(ERROR, "panic: "+value, attrs...)
+}
+
+// stackFrames splits a stack trace as produced by debug.Stack into frames.
+// Each frame combines the function line with the file and line number below it.
+// Parameters:
+// - stack: The stack trace.
+// Returns:
+// - One string per frame, e.g. "main.run() /app/main.go:12 +0x1d".
+func stackFrames(stack []byte) []string {
+	var frames []string
+	for _, line := range strings.Split(strings.TrimSpace(string(stack)), "\n") {
+		switch {
+		case line == "" || strings.HasPrefix(line, "goroutine "):
+			continue
+		case strings.HasPrefix(line, "\t") && len(frames) > 0:
+			frames[len(frames)-1] += " " + strings.TrimSpace(line)
+		default:
+			frames = append(frames, line)
+		}
+	}
+	return frames
+}
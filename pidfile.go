@@ -0,0 +1,41 @@
+package Logger
+
+import (
+	"os"
+	"strconv"
+)
+
+// WritePIDFile writes the current process ID to the given file.
+// The file is removed again by Close, which also runs when the program exits
+// through a FATAL message. Calling WritePIDFile again removes the previous PID file.
+// Parameters:
+// - path: The path to the PID file.
+// Returns:
+// - An error if the file cannot be written.
+func (l *Logger) WritePIDFile(path string) error {
+	if err := os.WriteFile(path, []byte(strconv.Itoa(os.Getpid())+"\n"), 0644); err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	previous := l.pidFile
+	l.pidFile = path
+	l.mu.Unlock()
+
+	if previous != "" && previous != path {
+		os.Remove(previous)
+	}
+	return nil
+}
+
+// removePIDFile removes the PID file written by WritePIDFile, if any.
+func (l *Logger) removePIDFile() {
+	l.mu.Lock()
+	path := l.pidFile
+	l.pidFile = ""
+	l.mu.Unlock()
+
+	if path != "" {
+		os.Remove(path)
+	}
+}
@@ -0,0 +1,43 @@
+package Logger
+
+// AddFieldProvider registers a function that contributes fields to every logged entry.
+// Providers are only called for messages whose level is enabled, once per entry.
+// Their fields are appended after the entry's own attributes, sorted by key.
+// On key collisions, the entry's own attributes win over provider fields, and a
+// provider registered later wins over one registered earlier.
+// Parameters:
+// - fn: The function returning the fields to add. It must be safe for concurrent use.
+func (l *Logger) AddFieldProvider(fn func() Fields) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.fieldProviders = append(l.fieldProviders, fn)
+}
+
+// withProviderFields returns attrs extended by the fields of all registered providers.
+// Parameters:
+// - attrs: The entry's own attributes. The slice is not modified.
+// Returns:
+// - The combined attributes.
+func (l *Logger) withProviderFields(attrs []Attr) []Attr {
+	l.mu.Lock()
+	providers := l.fieldProviders
+	l.mu.Unlock()
+
+	if len(providers) == 0 {
+		return attrs
+	}
+
+	fields := Fields{}
+	for _, provider := range providers {
+		for key, value := range provider() {
+			fields[key] = value
+		}
+	}
+	for _, attr := range attrs {
+		delete(fields, attr.Key)
+	}
+
+	combined := make([]Attr, 0, len(attrs)+len(fields))
+	combined = append(combined, attrs...)
+	return append(combined, fields.attrs()...)
+}
@@ -0,0 +1,16 @@
+package Logger
+
+// Ready logs the canonical readiness marker as an INFO entry.
+// The entry is "Ready ready=true" followed by the given fields sorted by key, e.g.
+// "Ready ready=true addr=:8080", so readiness probes can grep for one line across services.
+// Parameters:
+// - fields: Optional details such as the listen address; may be nil. A "ready" field is ignored.
+func (l *Logger) Ready(fields Fields) {
+	attrs := []Attr{Bool("ready", true)}
+	for _, attr := range fields.attrs() {
+		if attr.Key != "ready" {
+			attrs = append(attrs, attr)
+		}
+	}
+	l.log(INFO, "Ready", attrs...)
+}
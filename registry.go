@@ -0,0 +1,76 @@
+package Logger
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// sharedFile is a log file opened by one or more loggers in this process.
+type sharedFile struct {
+	file *os.File
+	refs int
+}
+
+var (
+	// openFilesMu guards openFiles
+	openFilesMu sync.Mutex
+	// openFiles maps absolute log file paths to the loggers' shared file
+	openFiles = map[string]*sharedFile{}
+)
+
+// openSharedFile opens a log file, or shares it if another logger already has it open.
+// Two loggers appending to the same file through separate handles would step on each
+// other's size tracking, so the second logger gets the same *os.File instead and a
+// warning is printed.
+// Parameters:
+// - path: The path to the log file.
+// Returns:
+// - The opened or shared file and an error if opening fails.
+func openSharedFile(path string) (*os.File, error) {
+	key, err := filepath.Abs(path)
+	if err != nil {
+		key = path
+	}
+
+	openFilesMu.Lock()
+	defer openFilesMu.Unlock()
+
+	if shared, exists := openFiles[key]; exists {
+		log.Printf("Log file %s is already open by another logger. Sharing it.\n", key)
+		shared.refs++
+		return shared.file, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	openFiles[key] = &sharedFile{file: file, refs: 1}
+	return file, nil
+}
+
+// releaseSharedFile drops one reference to a file from openSharedFile.
+// The file is closed once no logger uses it anymore.
+// Parameters:
+// - file: The file returned by openSharedFile.
+func releaseSharedFile(file *os.File) {
+	openFilesMu.Lock()
+	defer openFilesMu.Unlock()
+
+	for key, shared := range openFiles {
+		if shared.file != file {
+			continue
+		}
+		shared.refs--
+		if shared.refs == 0 {
+			delete(openFiles, key)
+			file.Close()
+		}
+		return
+	}
+
+	// Not registered, e.g. already released
+	file.Close()
+}
@@ -0,0 +1,84 @@
+package Logger
+
+import (
+	"math/rand"
+	"time"
+)
+
+// defaultMaxElapsed is the default time limit for sending one entry.
+const defaultMaxElapsed = 10 * time.Second
+
+// RetryPolicy controls how a network output retries failed writes. It is passed to
+// each output when it is added, e.g. to AddRemoteSyslogOutput.
+// The zero value keeps the default behavior: one immediate reconnect within at most
+// 10 seconds, and the entry is dropped if that fails too. Retries happen in the
+// background and never block logging, but entries queue up behind a retrying entry,
+// so keep MaxElapsed short.
+type RetryPolicy struct {
+	// InitialDelay is the wait before the first retry. Zero retries immediately.
+	InitialDelay time.Duration
+	// MaxDelay caps the wait between retries. Zero means no cap.
+	MaxDelay time.Duration
+	// Multiplier grows the delay after each retry. Values below 1 keep it constant.
+	Multiplier float64
+	// MaxAttempts is the total number of attempts per entry, including the first.
+	// Values below 1 mean 2 attempts.
+	MaxAttempts int
+	// MaxElapsed caps the total time spent on one entry, including reconnects.
+	// Zero means 10 seconds.
+	MaxElapsed time.Duration
+	// Jitter randomizes each delay by up to this fraction, e.g. 0.2 for ±20%.
+	Jitter float64
+	// FallbackFile receives entries whose retries are exhausted, one message per line,
+	// so they are not lost. Empty drops them.
+	FallbackFile string
+}
+
+// DroppedOutputEntries returns how many entries network outputs dropped because their queue was full.
+// Returns:
+// - The number of dropped entries across all network outputs of the logger.
+func (l *Logger) DroppedOutputEntries() int64 {
+	return l.outputDropped.Load()
+}
+
+// attempts returns the total number of attempts per entry.
+// Returns:
+// - MaxAttempts, or 2 if it is not set.
+func (p RetryPolicy) attempts() int {
+	if p.MaxAttempts < 1 {
+		return 2
+	}
+	return p.MaxAttempts
+}
+
+// maxElapsed returns the maximum time spent on one entry.
+// Returns:
+// - MaxElapsed, or 10 seconds if it is not set.
+func (p RetryPolicy) maxElapsed() time.Duration {
+	if p.MaxElapsed <= 0 {
+		return defaultMaxElapsed
+	}
+	return p.MaxElapsed
+}
+
+// delay returns the wait before the given retry.
+// Parameters:
+// - retry: The retry number, starting at 1.
+// Returns:
+// - The delay with backoff and jitter applied.
+func (p RetryPolicy) delay(retry int) time.Duration {
+	delay := float64(p.InitialDelay)
+	for i := 1; i < retry && p.Multiplier > 1; i++ {
+		delay *= p.Multiplier
+		if p.MaxDelay > 0 && delay >= float64(p.MaxDelay) {
+			break
+		}
+	}
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	if p.Jitter > 0 {
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(delay)
+}
@@ -0,0 +1,51 @@
+package Logger
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	backoff := RetryPolicy{InitialDelay: 100 * time.Millisecond, Multiplier: 2, MaxDelay: time.Second}
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		retry  int
+		want   time.Duration
+	}{
+		{"first retry", backoff, 1, 100 * time.Millisecond},
+		{"second retry", backoff, 2, 200 * time.Millisecond},
+		{"fourth retry", backoff, 4, 800 * time.Millisecond},
+		{"capped", backoff, 5, time.Second},
+		{"stays capped", backoff, 50, time.Second},
+		{"constant without multiplier", RetryPolicy{InitialDelay: 50 * time.Millisecond}, 5, 50 * time.Millisecond},
+		{"initial delay above cap", RetryPolicy{InitialDelay: 2 * time.Second, MaxDelay: time.Second}, 1, time.Second},
+		{"zero policy retries immediately", RetryPolicy{}, 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.delay(tt.retry); got != tt.want {
+				t.Errorf("delay(%d) = %s, want %s", tt.retry, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDelayJitter(t *testing.T) {
+	policy := RetryPolicy{InitialDelay: 100 * time.Millisecond, Jitter: 0.2}
+	for i := 0; i < 100; i++ {
+		if got := policy.delay(1); got < 80*time.Millisecond || got > 120*time.Millisecond {
+			t.Fatalf("delay(1) = %s, want within ±20%% of 100ms", got)
+		}
+	}
+}
+
+func TestRetryPolicyDefaults(t *testing.T) {
+	var policy RetryPolicy
+	if got := policy.attempts(); got != 2 {
+		t.Errorf("attempts() = %d, want 2", got)
+	}
+	if got := policy.maxElapsed(); got != defaultMaxElapsed {
+		t.Errorf("maxElapsed() = %s, want %s", got, defaultMaxElapsed)
+	}
+}
@@ -0,0 +1,90 @@
+package Logger
+
+import (
+	"fmt"
+	"time"
+)
+
+// adaptiveSampler keeps at most maxPerSecond messages of one level per second.
+// The ratio of kept messages is recalculated every second from the volume of the
+// previous second, so quiet periods log everything and spikes are sampled.
+type adaptiveSampler struct {
+	maxPerSecond int
+	windowStart  time.Time
+	seen         int
+	kept         int
+	dropped      int
+	rate         int
+}
+
+// SetAdaptiveSampling limits messages of a level to roughly maxPerSecond per second.
+// Every second the sampler looks at how many messages arrived in the previous second
+// and keeps 1 in N of them, where N is chosen so that at most maxPerSecond are written.
+// Within a second, messages beyond maxPerSecond are always dropped. Whenever messages
+// were dropped or the ratio changes, an INFO line reports the effective sample rate.
+// FATAL messages are never sampled.
+// Parameters:
+// - level: The log level to sample, e.g. DEBUG.
+// - maxPerSecond: The target maximum number of messages per second. Values below 1 disable sampling for the level.
+func (l *Logger) SetAdaptiveSampling(level LogLevel, maxPerSecond int) {
+	if level == FATAL {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if maxPerSecond < 1 {
+		delete(l.samplers, level)
+		return
+	}
+	if l.samplers == nil {
+		l.samplers = map[LogLevel]*adaptiveSampler{}
+	}
+	l.samplers[level] = &adaptiveSampler{maxPerSecond: maxPerSecond, windowStart: time.Now(), rate: 1}
+}
+
+// sampled reports whether a message of the given level passes adaptive sampling.
+// Parameters:
+// - level: The log level of the message.
+// Returns:
+// - True if the message should be logged.
+func (l *Logger) sampled(level LogLevel) bool {
+	l.mu.Lock()
+	sampler, exists := l.samplers[level]
+	if !exists {
+		l.mu.Unlock()
+		return true
+	}
+
+	var report string
+	now := time.Now()
+	if now.Sub(sampler.windowStart) >= time.Second {
+		previousRate := sampler.rate
+		sampler.rate = (sampler.seen + sampler.maxPerSecond - 1) / sampler.maxPerSecond
+		if sampler.rate < 1 {
+			sampler.rate = 1
+		}
+		if sampler.dropped > 0 || sampler.rate != previousRate {
+			report = fmt.Sprintf("Adaptive sampling for %s: keeping 1 in %d (%d messages in the last window, %d dropped).",
+				level, sampler.rate, sampler.seen, sampler.dropped)
+		}
+		sampler.windowStart = now
+		sampler.seen = 0
+		sampler.kept = 0
+		sampler.dropped = 0
+	}
+
+	keep := sampler.seen%sampler.rate == 0 && sampler.kept < sampler.maxPerSecond
+	sampler.seen++
+	if keep {
+		sampler.kept++
+	} else {
+		sampler.dropped++
+	}
+	l.mu.Unlock()
+
+	if report != "" {
+		l.log(INFO, report)
+	}
+	return keep
+}
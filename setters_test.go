@@ -0,0 +1,55 @@
+package Logger
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestSettersWhileLogging changes every setting while other goroutines log.
+// It only finds problems when run with -race.
+func TestSettersWhileLogging(t *testing.T) {
+	l, _ := newTestLogger(t, DEBUG)
+	stop := l.StartHeartbeat(time.Millisecond, "alive")
+	defer stop()
+
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-done:
+				return
+			default:
+				l.Info("hello", "world")
+				l.Derive("child").Warning("from child")
+			}
+		}
+	}()
+
+	for i := 0; i < 50; i++ {
+		l.SetLevel(LogLevel(i % int(OFF)))
+		l.SetEnvironment("staging")
+		l.SetMaxFields(i % 3)
+		l.SetMaxLineLength(i * 10)
+		l.SetFatalDowngrade(i%2 == 0)
+		l.SetCollapseWhitespace(i%2 == 0)
+		l.SetEmojiMode(i%2 == 0)
+		l.SetLevelSymbol(INFO, "i")
+		l.SetConsoleWrap(i%2 == 0)
+		l.SetConsoleWidth(i)
+		l.SetBellOnLevel(ERROR)
+		l.SetLineTerminator("\r\n")
+		l.SetMonotonicTimestamps(i%2 == 0)
+		l.SetDeltaTimestamps(i%2 == 0)
+		l.SetEmptyMessagePolicy(EmptyMessagePlaceholder)
+		l.SetFailOnAllOutputsDown(false)
+		l.SetOutputsDownHandler(5, func() {})
+		l.LogConfig()
+		time.Sleep(100 * time.Microsecond)
+	}
+	close(done)
+	wg.Wait()
+}
@@ -0,0 +1,46 @@
+package Logger
+
+import (
+	"io"
+	"sync"
+)
+
+// globalSink is a writer that receives the lines of every logger at or above minLevel.
+type globalSink struct {
+	minLevel LogLevel
+	writer   io.Writer
+}
+
+var (
+	// globalSinksMu guards globalSinks and serializes writes to them,
+	// since the same sink is shared by all loggers.
+	globalSinksMu sync.Mutex
+	globalSinks   []globalSink
+)
+
+// RegisterGlobalSink registers a writer that receives messages from all loggers.
+// Every logger, including ones created before the call, writes each message at or
+// above minLevel to the sink in addition to its own outputs. Lines are written
+// without color, exactly as they appear in the log file.
+// Parameters:
+// - minLevel: The minimum log level forwarded to the sink, e.g. ERROR.
+// - w: The writer receiving the log lines.
+func RegisterGlobalSink(minLevel LogLevel, w io.Writer) {
+	globalSinksMu.Lock()
+	defer globalSinksMu.Unlock()
+	globalSinks = append(globalSinks, globalSink{minLevel: minLevel, writer: w})
+}
+
+// writeGlobalSinks writes a log line to every global sink that accepts the level.
+// Parameters:
+// - level: The log level of the line.
+// - line: The formatted log line, including the line terminator.
+func writeGlobalSinks(level LogLevel, line []byte) {
+	globalSinksMu.Lock()
+	defer globalSinksMu.Unlock()
+	for _, sink := range globalSinks {
+		if level >= sink.minLevel {
+			sink.writer.Write(line)
+		}
+	}
+}
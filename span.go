@@ -0,0 +1,83 @@
+package Logger
+
+import (
+	"sync"
+	"time"
+)
+
+// Span is a unit of work started with Logger.Span and logged as a single entry by Finish.
+// Fields can be added while the span runs and are safe to set from several goroutines.
+type Span struct {
+	logger *Logger
+	name   string
+	start  time.Time
+
+	mu       sync.Mutex
+	fields   Fields
+	err      error
+	finished bool
+}
+
+// Span starts a span that is logged as one entry when it finishes.
+// Unlike Operation, which logs both the start and the end, nothing is written until
+// Finish is called. The entry then carries the span name, start time, duration, status
+// and all fields set in the meantime, which halves the log volume for frequent work.
+// Parameters:
+// - name: The name of the span, e.g. "render-page".
+// Returns:
+// - A pointer to the running Span.
+func (l *Logger) Span(name string) *Span {
+	return &Span{logger: l, name: name, start: time.Now(), fields: Fields{}}
+}
+
+// SetField attaches a field to the span entry. Setting the same key again replaces the value.
+// Parameters:
+// - key: The field name.
+// - value: The field value.
+func (s *Span) SetField(key string, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fields[key] = value
+}
+
+// SetError marks the span as failed. A nil error marks it as successful again.
+// Parameters:
+// - err: The error the span failed with.
+func (s *Span) SetError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err
+}
+
+// Finish logs the span as a single entry. Only the first call logs anything.
+// A successful span is logged as "Span finished" at INFO level with status=ok, a failed
+// one at ERROR level with status=error and an "error" attribute.
+func (s *Span) Finish() {
+	s.mu.Lock()
+	if s.finished {
+		s.mu.Unlock()
+		return
+	}
+	s.finished = true
+	fields, err := s.fields, s.err
+	s.mu.Unlock()
+
+	level, status := INFO, "ok"
+	if err != nil {
+		level, status = ERROR, "error"
+	}
+	if !s.logger.enabled(level) {
+		return
+	}
+
+	attrs := []Attr{
+		String("span", s.name),
+		Time("start", s.start),
+		String("duration", time.Since(s.start).String()),
+		String("status", status),
+	}
+	if err != nil {
+		attrs = append(attrs, String("error", err.Error()))
+	}
+	s.logger.log(level, "Span finished", append(attrs, fields.attrs()...)...)
+}
@@ -0,0 +1,147 @@
+package Logger
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+	"sync/atomic"
+)
+
+// spillFile buffers messages on disk while a network output is down.
+// Messages are stored one per line as quoted strings, so embedded newlines survive.
+// It is used under the owning output's lock.
+type spillFile struct {
+	path    string
+	maxSize int64
+	size    int64
+	dropped *atomic.Int64
+}
+
+// SetSpillFile makes network outputs spill entries to a local file while their server is down.
+// When an entry still fails after the retries of its RetryPolicy, it is appended to the spill
+// file instead of the fallback file. As soon as a write succeeds again, the spilled entries
+// are sent first, in order, and the file is truncated. Entries left over from a previous run
+// are sent as well. When the file would exceed maxSize, the oldest entries are dropped and
+// counted (see DroppedSpillEntries). Each output keeps the spill file that was set when it
+// was added, so use a separate path for each network output.
+// Parameters:
+// - path: The path to the spill file. Empty disables spilling for outputs added afterwards.
+// - maxSize: The maximum size of the spill file in bytes. Values below 1 mean no limit.
+func (l *Logger) SetSpillFile(path string, maxSize int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.spillPath = path
+	l.spillMaxSize = maxSize
+}
+
+// DroppedSpillEntries returns how many spilled entries were dropped because a spill file was full.
+// Returns:
+// - The number of dropped entries across all network outputs of the logger.
+func (l *Logger) DroppedSpillEntries() int64 {
+	return l.spillDropped.Load()
+}
+
+// newSpillFile returns the spill file for a network output being added, if one is set.
+// Returns:
+// - The spill file, or nil if spilling is disabled.
+func (l *Logger) newSpillFile() *spillFile {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.spillPath == "" {
+		return nil
+	}
+
+	spill := &spillFile{path: l.spillPath, maxSize: l.spillMaxSize, dropped: &l.spillDropped}
+	if info, err := os.Stat(spill.path); err == nil {
+		spill.size = info.Size()
+	}
+	return spill
+}
+
+// add appends a message to the spill file, dropping the oldest messages if it is full.
+// Parameters:
+// - message: The message to spill.
+func (s *spillFile) add(message string) {
+	line := strconv.AppendQuote(nil, message)
+	line = append(line, '\n')
+
+	if s.maxSize > 0 && s.size+int64(len(line)) > s.maxSize {
+		lines := s.read()
+		size := s.size
+		dropped := 0
+		for len(lines) > 0 && size+int64(len(line)) > s.maxSize {
+			size -= int64(len(lines[0]))
+			lines = lines[1:]
+			dropped++
+		}
+		if size+int64(len(line)) > s.maxSize {
+			// The message alone does not fit
+			s.dropped.Add(1)
+			return
+		}
+		s.dropped.Add(int64(dropped))
+		s.rewrite(lines)
+	}
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return
+	}
+	defer file.Close()
+	if n, err := file.Write(line); err == nil {
+		s.size += int64(n)
+	}
+}
+
+// drain sends the spilled messages in order and truncates the spill file.
+// If sending fails, the unsent messages stay in the file.
+// Parameters:
+// - send: Sends one message and reports whether it succeeded.
+// Returns:
+// - True if the spill file is empty afterwards.
+func (s *spillFile) drain(send func(message string) bool) bool {
+	if s.size == 0 {
+		return true
+	}
+
+	lines := s.read()
+	for i, line := range lines {
+		message, err := strconv.Unquote(string(bytes.TrimSuffix(line, []byte("\n"))))
+		if err != nil {
+			continue
+		}
+		if !send(message) {
+			s.rewrite(lines[i:])
+			return false
+		}
+	}
+	s.rewrite(nil)
+	return true
+}
+
+// read returns the lines of the spill file, each with its trailing newline.
+// A last line without a newline was cut off, e.g. by a crash while spilling, and is skipped.
+// Returns:
+// - The lines of the file, or nil if it cannot be read.
+func (s *spillFile) read() [][]byte {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil
+	}
+	lines := bytes.SplitAfter(data, []byte("\n"))
+	if last := lines[len(lines)-1]; !bytes.HasSuffix(last, []byte("\n")) {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// rewrite replaces the contents of the spill file with the given lines.
+// Parameters:
+// - lines: The lines to keep, each with its trailing newline.
+func (s *spillFile) rewrite(lines [][]byte) {
+	data := bytes.Join(lines, nil)
+	if err := os.WriteFile(s.path, data, 0644); err != nil {
+		return
+	}
+	s.size = int64(len(data))
+}
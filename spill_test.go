@@ -0,0 +1,163 @@
+package Logger
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestSpillFile(t *testing.T, maxSize int64) *spillFile {
+	t.Helper()
+	return &spillFile{path: filepath.Join(t.TempDir(), "spill"), maxSize: maxSize, dropped: new(atomic.Int64)}
+}
+
+// drainAll drains the spill file and returns the messages in the order they were sent.
+func drainAll(t *testing.T, s *spillFile) []string {
+	t.Helper()
+	var sent []string
+	if !s.drain(func(message string) bool {
+		sent = append(sent, message)
+		return true
+	}) {
+		t.Fatal("drain failed")
+	}
+	return sent
+}
+
+func TestSpillFileDropsOldest(t *testing.T) {
+	// Each message takes 5 bytes: the quoted message and a newline
+	s := newTestSpillFile(t, 15)
+	for _, message := range []string{"m1", "m2", "m3", "m4", "m5"} {
+		s.add(message)
+	}
+
+	if got := s.dropped.Load(); got != 2 {
+		t.Errorf("dropped = %d, want 2", got)
+	}
+	if got, want := drainAll(t, s), []string{"m3", "m4", "m5"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("replayed %q, want %q", got, want)
+	}
+	if s.size != 0 {
+		t.Errorf("size after drain = %d, want 0", s.size)
+	}
+}
+
+func TestSpillFileDropsMessageThatDoesNotFit(t *testing.T) {
+	s := newTestSpillFile(t, 4)
+	s.add("too long")
+
+	if got := s.dropped.Load(); got != 1 {
+		t.Errorf("dropped = %d, want 1", got)
+	}
+	if _, err := os.Stat(s.path); !os.IsNotExist(err) {
+		t.Errorf("spill file was created for a message that does not fit")
+	}
+}
+
+func TestSpillFileDrainKeepsUnsent(t *testing.T) {
+	s := newTestSpillFile(t, 0)
+	for _, message := range []string{"a", "b\nwith newline", "c"} {
+		s.add(message)
+	}
+
+	var sent []string
+	ok := s.drain(func(message string) bool {
+		if message != "a" {
+			return false
+		}
+		sent = append(sent, message)
+		return true
+	})
+	if ok || !reflect.DeepEqual(sent, []string{"a"}) {
+		t.Fatalf("drain = %v, sent %q, want false after sending only \"a\"", ok, sent)
+	}
+
+	if got, want := drainAll(t, s), []string{"b\nwith newline", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("replayed %q, want %q", got, want)
+	}
+}
+
+func TestSpillFileSkipsCutOffLine(t *testing.T) {
+	s := newTestSpillFile(t, 0)
+	s.add("complete")
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file.WriteString(`"cut of`)
+	file.Close()
+	s.size += 7
+
+	if got, want := drainAll(t, s), []string{"complete"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("replayed %q, want %q", got, want)
+	}
+}
+
+func TestSyslogSpillsAndReplaysOnReconnect(t *testing.T) {
+	// An address nobody listens on
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadAddr := listener.Addr().String()
+	listener.Close()
+
+	output := &syslogOutput{
+		network: "tcp",
+		addr:    deadAddr,
+		policy:  RetryPolicy{MaxAttempts: 1, MaxElapsed: time.Second},
+		dropped: new(atomic.Int64),
+		spill:   newTestSpillFile(t, 0),
+	}
+	output.deliver("one")
+	output.deliver("two")
+	if output.healthy.Load() {
+		t.Fatal("output is healthy without a server")
+	}
+
+	listener, err = net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	received := make(chan []byte, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		conn.SetReadDeadline(time.Now().Add(time.Second))
+		var data []byte
+		buffer := make([]byte, 64)
+		for {
+			n, err := conn.Read(buffer)
+			data = append(data, buffer[:n]...)
+			if err != nil {
+				received <- data
+				return
+			}
+		}
+	}()
+
+	output.addr = listener.Addr().String()
+	output.deliver("three")
+	if !output.healthy.Load() {
+		t.Fatal("output is not healthy after reconnecting")
+	}
+	output.mu.Lock()
+	output.conn.Close()
+	output.mu.Unlock()
+
+	// Spilled messages go out first and in order, framed with octet counting
+	if got, want := string(<-received), "3 one3 two5 three"; got != want {
+		t.Errorf("server received %q, want %q", got, want)
+	}
+	if output.spill.size != 0 {
+		t.Errorf("spill size after replay = %d, want 0", output.spill.size)
+	}
+}
@@ -0,0 +1,49 @@
+package Logger
+
+import (
+	"strings"
+	"time"
+)
+
+// SetCloseSummary writes a one-line summary of the session when the logger is closed.
+// The summary is the last INFO line before the log file is closed and contains the
+// total number of lines, the count per level, the bytes written to the log file and
+// the uptime of the logger. It is written at most once, even if the level is above
+// INFO, the logger is muted or sampling is enabled.
+// Parameters:
+// - enabled: Whether to write the summary on Close.
+func (l *Logger) SetCloseSummary(enabled bool) {
+	l.closeSummary.Store(enabled)
+}
+
+// countLine records a written line for the close summary.
+// Parameters:
+// - level: The log level of the line.
+// - size: The number of bytes written to the log file, 0 if there is none or the write failed.
+func (l *Logger) countLine(level LogLevel, size int) {
+	l.lineCounts[level].Add(1)
+	l.bytesWritten.Add(int64(size))
+}
+
+// writeCloseSummary logs the session summary if it is enabled and has not been written yet.
+func (l *Logger) writeCloseSummary() {
+	if !l.closeSummary.CompareAndSwap(true, false) {
+		return
+	}
+
+	var total int64
+	attrs := []Attr{}
+	for level := DEBUG; level <= FATAL; level++ {
+		count := l.lineCounts[level].Load()
+		total += count
+		attrs = append(attrs, Attr{Key: strings.ToLower(level.String()), Value: count})
+	}
+	attrs = append([]Attr{{Key: "lines", Value: total}}, attrs...)
+	attrs = append(attrs,
+		Attr{Key: "bytes", Value: l.bytesWritten.Load()},
+		String("uptime", time.Since(l.start).Round(time.Second).String()),
+	)
+
+	// Write past the level, mute and sampling filters: the summary is requested explicitly
+	l.emit(INFO, "Session summary", attrs)
+}
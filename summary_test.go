@@ -0,0 +1,34 @@
+package Logger
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCloseSummaryBypassesFilters(t *testing.T) {
+	l, path := newTestLogger(t, ERROR)
+	l.SetCloseSummary(true)
+	l.Error("first")
+	l.Mute(time.Now().Add(time.Hour), FATAL)
+	l.Error("muted")
+	l.Close()
+
+	got := readLog(t, path)
+	if !strings.Contains(got, "Session summary lines=1 ") || !strings.Contains(got, " error=1 ") {
+		t.Fatalf("summary missing or wrong:\n%s", got)
+	}
+}
+
+func TestCloseSummaryCountsFileBytes(t *testing.T) {
+	l, path := newTestLogger(t, INFO)
+	l.SetCloseSummary(true)
+	l.Info("hello")
+	size := len(readLog(t, path))
+	l.Close()
+
+	if got := readLog(t, path); !strings.Contains(got, fmt.Sprintf(" bytes=%d ", size)) {
+		t.Fatalf("want bytes=%d in summary:\n%s", size, got)
+	}
+}
@@ -0,0 +1,471 @@
+package Logger
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SyslogFacility is a syslog facility code as defined by RFC 5424.
+type SyslogFacility int
+
+// Defining the syslog facilities
+const (
+	LOG_KERN SyslogFacility = iota
+	LOG_USER
+	LOG_MAIL
+	LOG_DAEMON
+	LOG_AUTH
+	LOG_SYSLOG
+	LOG_LPR
+	LOG_NEWS
+	LOG_UUCP
+	LOG_CRON
+	LOG_AUTHPRIV
+	LOG_FTP
+	_ // 12 to 15 are reserved for NTP, audit, alert and clock daemon
+	_
+	_
+	_
+	LOG_LOCAL0
+	LOG_LOCAL1
+	LOG_LOCAL2
+	LOG_LOCAL3
+	LOG_LOCAL4
+	LOG_LOCAL5
+	LOG_LOCAL6
+	LOG_LOCAL7
+)
+
+// syslogSDID is the structured data ID under which entry attributes are sent.
+// 32473 is the private enterprise number reserved for documentation and examples.
+const syslogSDID = "fields@32473"
+
+// syslogTimestampLayout is the RFC 5424 TIMESTAMP, which allows at most six fractional digits.
+const syslogTimestampLayout = "2006-01-02T15:04:05.000000Z07:00"
+
+// Timeouts and limits of the remote syslog output
+const (
+	syslogDialTimeout  = 5 * time.Second
+	syslogWriteTimeout = 5 * time.Second
+	syslogCloseTimeout = 5 * time.Second
+	syslogQueueSize    = 1024
+)
+
+// The maximum lengths of the RFC 5424 header fields
+const (
+	syslogMaxHostname = 255
+	syslogMaxAppName  = 48
+	syslogMaxMsgID    = 32
+)
+
+// syslogSeverity maps a log level to its RFC 5424 severity.
+// Parameters:
+// - level: The log level.
+// Returns:
+// - The syslog severity (7 debug, 6 informational, 4 warning, 3 error, 2 critical).
+func syslogSeverity(level LogLevel) int {
+	switch level {
+	case DEBUG:
+		return 7
+	case INFO:
+		return 6
+	case WARNING:
+		return 4
+	case ERROR:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// syslogOutput sends entries to a remote syslog server in RFC 5424 format.
+type syslogOutput struct {
+	logger   *Logger
+	network  string
+	addr     string
+	appName  string
+	hostname string
+	facility SyslogFacility
+	policy   RetryPolicy
+	dropped  *atomic.Int64
+	healthy  atomic.Bool
+	closing  atomic.Bool
+	done     chan struct{}
+
+	// queueMu guards the queue against sends after close
+	queueMu sync.Mutex
+	queue   chan string
+	closed  bool
+
+	// mu guards the connection and the files, used by the sender goroutine
+	mu       sync.Mutex
+	conn     net.Conn
+	fallback *os.File
+	spill    *spillFile
+	stopped  bool
+}
+
+// AddRemoteSyslogOutput sends every entry to a remote syslog server using RFC 5424.
+// Each message carries the hostname, app name, process ID and logger name (as MSGID)
+// in its header and the entry attributes as structured data. The PRI value is computed as facility*8+severity.
+// Over TCP, messages are framed with octet counting (RFC 6587); over UDP, each
+// message is sent as one datagram. Messages are sent by a background goroutine, so a
+// slow or unreachable server never blocks logging; when its queue is full, entries
+// are dropped and counted (see DroppedOutputEntries). A failed write reconnects and
+// retries as configured by the retry policy; the zero RetryPolicy reconnects once.
+// Entries that still fail go to the spill file set with SetSpillFile, if any.
+// Parameters:
+// - network: "udp" or "tcp".
+// - addr: The server address, e.g. "logs.example.com:514".
+// - appName: The APP-NAME header field, e.g. "billing".
+// - facility: The facility assigned to the application, e.g. LOG_LOCAL0 or LOG_DAEMON.
+// - policy: How failed writes are retried, or RetryPolicy{} for the default.
+// Returns:
+// - An error if the facility is invalid or the connection cannot be established.
+func (l *Logger) AddRemoteSyslogOutput(network, addr, appName string, facility SyslogFacility, policy RetryPolicy) error {
+	if facility < LOG_KERN || facility > LOG_LOCAL7 {
+		return fmt.Errorf("invalid syslog facility %d", int(facility))
+	}
+
+	conn, err := net.DialTimeout(network, addr, syslogDialTimeout)
+	if err != nil {
+		return err
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+
+	output := &syslogOutput{
+		logger:   l,
+		network:  network,
+		addr:     addr,
+		appName:  syslogHeaderField(appName, syslogMaxAppName),
+		hostname: syslogHeaderField(hostname, syslogMaxHostname),
+		facility: facility,
+		policy:   policy,
+		dropped:  &l.outputDropped,
+		done:     make(chan struct{}),
+		queue:    make(chan string, syslogQueueSize),
+		conn:     conn,
+		spill:    l.newSpillFile(),
+	}
+	output.healthy.Store(true)
+	go output.run()
+
+	l.addOutput(output)
+	return nil
+}
+
+// writeEntry formats the entry and queues it for the sender goroutine.
+// It never blocks: when the queue is full, the entry is dropped and counted.
+// Parameters:
+// - entry: The entry to send.
+// Returns:
+// - True if the entry was queued and the last message reached the server.
+func (o *syslogOutput) writeEntry(entry LogEntry) bool {
+	message := o.format(entry)
+
+	o.queueMu.Lock()
+	defer o.queueMu.Unlock()
+	if o.closed {
+		return false
+	}
+	select {
+	case o.queue <- message:
+		return o.healthy.Load()
+	default:
+		o.dropped.Add(1)
+		return false
+	}
+}
+
+// run sends queued messages until the queue is closed.
+func (o *syslogOutput) run() {
+	defer close(o.done)
+	for message := range o.queue {
+		o.deliver(message)
+	}
+}
+
+// deliver sends one message, retrying as configured by the retry policy.
+// Messages whose retries are exhausted go to the spill file or the fallback file.
+// While the output is closing, it does not reconnect, and if the server is unreachable,
+// messages skip the network so that Close does not wait for the retries of every queued
+// message. Messages still queued when Close gave up waiting are dropped and counted.
+// Parameters:
+// - message: The message without transport framing.
+func (o *syslogOutput) deliver(message string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.stopped {
+		// Close released the connection and the files; reopening them would leak them
+		o.dropped.Add(1)
+		return
+	}
+
+	if !o.closing.Load() || o.healthy.Load() {
+		deadline := time.Now().Add(o.policy.maxElapsed())
+		for attempt := 1; attempt <= o.policy.attempts(); attempt++ {
+			if attempt > 1 {
+				wait := o.policy.delay(attempt - 1)
+				if time.Until(deadline) < wait {
+					break
+				}
+				time.Sleep(wait)
+			}
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				break
+			}
+
+			// Reconnect, e.g. after the server restarted, but never once Close has started
+			if o.conn == nil {
+				if o.closing.Load() {
+					break
+				}
+				conn, err := net.DialTimeout(o.network, o.addr, min(syslogDialTimeout, remaining))
+				if err != nil {
+					continue
+				}
+				o.conn = conn
+			}
+
+			// Spilled entries are older, so they go out first
+			if o.spill != nil && !o.spill.drain(o.send) {
+				continue
+			}
+			if o.send(message) {
+				o.healthy.Store(true)
+				return
+			}
+		}
+	}
+	o.healthy.Store(false)
+
+	if o.spill != nil {
+		o.spill.add(message)
+		return
+	}
+	o.writeFallback(message)
+}
+
+// send writes one message to the current connection with the transport framing.
+// On failure the connection is closed, so the next attempt reconnects.
+// Must be called with o.mu held.
+// Parameters:
+// - message: The message without transport framing.
+// Returns:
+// - True if the message was written.
+func (o *syslogOutput) send(message string) bool {
+	if strings.HasPrefix(o.network, "tcp") {
+		message = strconv.Itoa(len(message)) + " " + message
+	}
+	if o.conn == nil {
+		return false
+	}
+	o.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout))
+	if _, err := o.conn.Write([]byte(message)); err == nil {
+		return true
+	}
+	o.conn.Close()
+	o.conn = nil
+	return false
+}
+
+// writeFallback appends a message whose retries are exhausted to the fallback file, if any.
+// Must be called with o.mu held.
+// Parameters:
+// - message: The message without transport framing.
+func (o *syslogOutput) writeFallback(message string) {
+	if o.policy.FallbackFile == "" {
+		return
+	}
+	if o.fallback == nil {
+		file, err := os.OpenFile(o.policy.FallbackFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return
+		}
+		o.fallback = file
+	}
+	o.fallback.WriteString(message + "\n")
+}
+
+// format renders an entry as an RFC 5424 message.
+// Messages longer than the logger's SetMaxLineLength are shortened while staying valid:
+// the MSG part is cut and marked first, and the structured data is replaced with "-"
+// if it does not fit. If the marker does not fit either, the MSG part is dropped. The
+// header is never cut, so a message whose header alone exceeds the limit stays longer.
+// Parameters:
+// - entry: The entry to format.
+// Returns:
+// - The message without transport framing.
+func (o *syslogOutput) format(entry LogEntry) string {
+	var header strings.Builder
+	header.WriteByte('<')
+	header.WriteString(strconv.Itoa(int(o.facility)*8 + syslogSeverity(entry.Level)))
+	header.WriteString(">1 ")
+	header.WriteString(entry.Time.Format(syslogTimestampLayout))
+	header.WriteByte(' ')
+	header.WriteString(o.hostname)
+	header.WriteByte(' ')
+	header.WriteString(o.appName)
+	header.WriteByte(' ')
+	header.WriteString(strconv.Itoa(os.Getpid()))
+	header.WriteByte(' ')
+	header.WriteString(syslogHeaderField(entry.Name, syslogMaxMsgID))
+	header.WriteByte(' ')
+
+	var data strings.Builder
+	if len(entry.Attrs) == 0 {
+		data.WriteByte('-')
+	} else {
+		data.WriteString("[" + syslogSDID)
+		for _, attr := range entry.Attrs {
+			data.WriteByte(' ')
+			data.WriteString(syslogParamName(attr.Key))
+			data.WriteString(`="`)
+			data.WriteString(syslogParamValue(string(appendValue(nil, attr.Value))))
+			data.WriteByte('"')
+		}
+		data.WriteByte(']')
+	}
+
+	return fitSyslogMessage(header.String(), data.String(), entry.Message, int(o.logger.maxLineLength.Load()))
+}
+
+// fitSyslogMessage joins the parts of an RFC 5424 message and shortens it to the maximum length.
+// Parameters:
+// - header: The header, ending in a space.
+// - data: The structured data, or "-".
+// - msg: The MSG part, possibly empty.
+// - max: The maximum length in bytes, or 0 for no limit.
+// Returns:
+// - The message.
+func fitSyslogMessage(header, data, msg string, max int) string {
+	message := header + data
+	if msg != "" {
+		message += " " + msg
+	}
+	if max <= 0 || len(message) <= max {
+		return message
+	}
+
+	// Cut the MSG part first and keep the structured data
+	keep := len(header) + len(data) + 1
+	if msg != "" && keep+len(truncatedMarker) <= max {
+		return string(truncateLine([]byte(message), max, keep))
+	}
+
+	// Structured data cannot be cut without breaking it, so it is dropped
+	message = header + "-"
+	if msg == "" {
+		return message
+	}
+	message += " " + msg
+	if len(message) <= max {
+		return message
+	}
+	keep = len(header) + 2
+	if keep+len(truncatedMarker) > max {
+		// Not even the marker fits, so the MSG part is left out entirely
+		return header + "-"
+	}
+	return string(truncateLine([]byte(message), max, keep))
+}
+
+// close closes the connection to the server.
+// Returns:
+// - An error if closing the connection fails.
+func (o *syslogOutput) close() error {
+	o.queueMu.Lock()
+	if !o.closed {
+		o.closed = true
+		close(o.queue)
+	}
+	o.queueMu.Unlock()
+
+	// Send what is queued, but give up on an unreachable server quickly
+	o.closing.Store(true)
+	select {
+	case <-o.done:
+	case <-time.After(syslogCloseTimeout):
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.stopped = true
+	if o.fallback != nil {
+		o.fallback.Close()
+		o.fallback = nil
+	}
+	if o.conn == nil {
+		return nil
+	}
+	err := o.conn.Close()
+	o.conn = nil
+	return err
+}
+
+// syslogHeaderField makes a value safe for an RFC 5424 header field.
+// Header fields are printable US-ASCII without spaces and limited in length;
+// "-" stands for an empty value.
+// Parameters:
+// - value: The header value.
+// - maxLength: The maximum length of the field.
+// Returns:
+// - The sanitized value, truncated to maxLength.
+func syslogHeaderField(value string, maxLength int) string {
+	value = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+		return r
+	}, value)
+	if len(value) > maxLength {
+		value = value[:maxLength]
+	}
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// syslogParamName makes an attribute key safe as an RFC 5424 SD-PARAM name.
+// Names are printable US-ASCII without '=', ' ', ']' and '"', at most 32 characters long.
+// Parameters:
+// - key: The attribute key.
+// Returns:
+// - The sanitized parameter name.
+func syslogParamName(key string) string {
+	name := strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 || r == '=' || r == ']' || r == '"' {
+			return '_'
+		}
+		return r
+	}, key)
+	if len(name) > 32 {
+		name = name[:32]
+	}
+	if name == "" {
+		return "_"
+	}
+	return name
+}
+
+// syslogParamValue escapes '"', '\' and ']' in an SD-PARAM value as required by RFC 5424.
+// Parameters:
+// - value: The parameter value.
+// Returns:
+// - The escaped value.
+func syslogParamValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+}
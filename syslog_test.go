@@ -0,0 +1,214 @@
+package Logger
+
+import (
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// listenSyslog starts a TCP server that accepts connections and discards their data.
+func listenSyslog(t *testing.T) (string, *atomic.Int32) {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+
+	accepted := new(atomic.Int32)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			accepted.Add(1)
+			go func() {
+				buffer := make([]byte, 1024)
+				for {
+					if _, err := conn.Read(buffer); err != nil {
+						conn.Close()
+						return
+					}
+				}
+			}()
+		}
+	}()
+	return listener.Addr().String(), accepted
+}
+
+func TestSyslogDoesNotReconnectAfterClose(t *testing.T) {
+	addr, accepted := listenSyslog(t)
+	l, _ := newTestLogger(t, INFO)
+	if err := l.AddRemoteSyslogOutput("tcp", addr, "test", LOG_LOCAL0, RetryPolicy{}); err != nil {
+		t.Fatal(err)
+	}
+	output := l.outputs[0].(*syslogOutput)
+	output.close()
+
+	// A message the sender goroutine was still holding when Close gave up
+	output.deliver("late")
+	time.Sleep(50 * time.Millisecond)
+
+	if got := accepted.Load(); got != 1 {
+		t.Fatalf("connections = %d, want 1 (no reconnect after close)", got)
+	}
+	if got := l.DroppedOutputEntries(); got != 1 {
+		t.Fatalf("dropped = %d, want 1", got)
+	}
+}
+
+func TestSyslogDoesNotReconnectWhileClosing(t *testing.T) {
+	addr, accepted := listenSyslog(t)
+	l, _ := newTestLogger(t, INFO)
+	if err := l.AddRemoteSyslogOutput("tcp", addr, "test", LOG_LOCAL0, RetryPolicy{}); err != nil {
+		t.Fatal(err)
+	}
+	output := l.outputs[0].(*syslogOutput)
+
+	// Close timed out and dropped the connection while messages are still queued
+	output.closing.Store(true)
+	output.mu.Lock()
+	output.conn.Close()
+	output.conn = nil
+	output.mu.Unlock()
+
+	output.deliver("queued")
+	time.Sleep(50 * time.Millisecond)
+
+	if got := accepted.Load(); got != 1 {
+		t.Fatalf("connections = %d, want 1 (no reconnect while closing)", got)
+	}
+	output.mu.Lock()
+	defer output.mu.Unlock()
+	if output.conn != nil {
+		t.Fatal("deliver opened a new connection while closing")
+	}
+}
+
+func TestSyslogFormat(t *testing.T) {
+	l, _ := newTestLogger(t, DEBUG)
+	at := time.Date(2026, 1, 2, 3, 4, 5, 123456789, time.UTC)
+	pid := strconv.Itoa(os.Getpid())
+
+	tests := []struct {
+		name     string
+		facility SyslogFacility
+		appName  string
+		entry    LogEntry
+		want     string
+	}{
+		{
+			name:     "kern debug",
+			facility: LOG_KERN,
+			appName:  "app",
+			entry:    LogEntry{Time: at, Level: DEBUG, Message: "hi"},
+			want:     "<7>1 2026-01-02T03:04:05.123456Z host app " + pid + " - - hi",
+		},
+		{
+			name:     "user info",
+			facility: LOG_USER,
+			appName:  "app",
+			entry:    LogEntry{Time: at, Level: INFO, Message: "hi"},
+			want:     "<14>1 2026-01-02T03:04:05.123456Z host app " + pid + " - - hi",
+		},
+		{
+			name:     "daemon warning",
+			facility: LOG_DAEMON,
+			appName:  "app",
+			entry:    LogEntry{Time: at, Level: WARNING, Message: "hi"},
+			want:     "<28>1 2026-01-02T03:04:05.123456Z host app " + pid + " - - hi",
+		},
+		{
+			name:     "local0 error",
+			facility: LOG_LOCAL0,
+			appName:  "app",
+			entry:    LogEntry{Time: at, Level: ERROR, Message: "hi"},
+			want:     "<131>1 2026-01-02T03:04:05.123456Z host app " + pid + " - - hi",
+		},
+		{
+			name:     "local7 fatal",
+			facility: LOG_LOCAL7,
+			appName:  "app",
+			entry:    LogEntry{Time: at, Level: FATAL, Message: "hi"},
+			want:     "<186>1 2026-01-02T03:04:05.123456Z host app " + pid + " - - hi",
+		},
+		{
+			name:     "msgid from logger name",
+			facility: LOG_LOCAL0,
+			appName:  "app",
+			entry:    LogEntry{Time: at, Level: INFO, Name: "db.pool", Message: "hi"},
+			want:     "<134>1 2026-01-02T03:04:05.123456Z host app " + pid + " db.pool - hi",
+		},
+		{
+			name:     "empty app name and message",
+			facility: LOG_LOCAL0,
+			appName:  syslogHeaderField("", syslogMaxAppName),
+			entry:    LogEntry{Time: at, Level: INFO},
+			want:     "<134>1 2026-01-02T03:04:05.123456Z host - " + pid + " - -",
+		},
+		{
+			name:     "sd-param escaping",
+			facility: LOG_LOCAL0,
+			appName:  "app",
+			entry: LogEntry{Time: at, Level: INFO, Message: "hi", Attrs: []Attr{
+				String("quote", `say "hi"`),
+				String("slash", `C:\tmp`),
+				String("bracket", "a]b"),
+			}},
+			want: "<134>1 2026-01-02T03:04:05.123456Z host app " + pid +
+				` - [fields@32473 quote="say \"hi\"" slash="C:\\tmp" bracket="a\]b"] hi`,
+		},
+		{
+			name:     "sd-param name sanitized",
+			facility: LOG_LOCAL0,
+			appName:  "app",
+			entry:    LogEntry{Time: at, Level: INFO, Message: "hi", Attrs: []Attr{Int(`a=b"c]`, 1)}},
+			want:     "<134>1 2026-01-02T03:04:05.123456Z host app " + pid + ` - [fields@32473 a_b_c_="1"] hi`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := &syslogOutput{logger: l, hostname: "host", appName: tt.appName, facility: tt.facility}
+			if got := output.format(tt.entry); got != tt.want {
+				t.Errorf("format() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFitSyslogMessage(t *testing.T) {
+	header := strings.Repeat("h", 47) + " "
+	data := `[fields@32473 a="1"]`
+	tests := []struct {
+		name string
+		data string
+		msg  string
+		max  int
+		want string
+	}{
+		{"no limit", data, "hello", 0, header + data + " hello"},
+		{"fits", data, "hello", 200, header + data + " hello"},
+		{"cuts msg and keeps data", data, strings.Repeat("m", 50), 100, header + data + " " + strings.Repeat("m", 100-len(header)-len(data)-1-len(truncatedMarker)) + truncatedMarker},
+		{"drops data to keep msg", data, "hello", 60, header + "- hello"},
+		{"cuts msg without data", data, strings.Repeat("m", 50), 70, header + "- " + strings.Repeat("m", 70-len(header)-2-len(truncatedMarker)) + truncatedMarker},
+		{"marker does not fit", data, strings.Repeat("m", 50), 60, header + "-"},
+		{"no msg", data, "", 60, header + "-"},
+		{"header exceeds limit", data, "hello", 10, header + "-"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := fitSyslogMessage(header, tt.data, tt.msg, tt.max)
+			if got != tt.want {
+				t.Errorf("fitSyslogMessage() =\n%q\nwant\n%q", got, tt.want)
+			}
+			if tt.max >= len(header)+1 && len(got) > tt.max {
+				t.Errorf("length %d exceeds max %d", len(got), tt.max)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package Logger
+
+import "os"
+
+// NewTempLogger creates a logger that writes to a new, uniquely named file in os.TempDir.
+// The file is removed, not just closed, when the logger is closed, and the finalizer
+// closes it as a fallback. This suits capturing the logs of a short-lived subprocess
+// or a test for a report and discarding them afterwards. Nothing is printed to the console.
+// Parameters:
+// - level: The minimum log level the logger should display.
+// Returns:
+// - A pointer to a Logger instance.
+// - The path of the temporary log file.
+// - An error if the file cannot be created.
+func NewTempLogger(level LogLevel) (*Logger, string, error) {
+	file, err := os.CreateTemp("", "logger-*.log")
+	if err != nil {
+		return nil, "", err
+	}
+	path := file.Name()
+	file.Close()
+
+	logger, err := NewLogger(level, path, false)
+	if err != nil {
+		os.Remove(path)
+		return nil, "", err
+	}
+
+	logger.mu.Lock()
+	logger.tempFile = path
+	logger.mu.Unlock()
+	return logger, path, nil
+}
+
+// removeTempFile removes the log file created by NewTempLogger, if any.
+func (l *Logger) removeTempFile() {
+	l.mu.Lock()
+	path := l.tempFile
+	l.tempFile = ""
+	l.mu.Unlock()
+
+	if path != "" {
+		os.Remove(path)
+	}
+}
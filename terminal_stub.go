@@ -0,0 +1,11 @@
+//go:build !(linux || darwin || dragonfly || freebsd || netbsd || openbsd)
+
+package Logger
+
+// terminalWidth is not supported on this platform, so console lines are only
+// wrapped at a width set with SetConsoleWidth.
+// Returns:
+// - Always 0.
+func terminalWidth() int {
+	return 0
+}
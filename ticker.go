@@ -0,0 +1,49 @@
+package Logger
+
+import (
+	"sync"
+	"time"
+)
+
+// startTicker calls fn every interval in a background goroutine.
+// Parameters:
+// - interval: The time between two calls. Must be positive.
+// - fn: The function to call.
+// Returns:
+// - A function that stops the goroutine. It is safe to call more than once.
+func startTicker(interval time.Duration, fn func()) (stop func()) {
+	done := make(chan struct{})
+	var once sync.Once
+	stop = func() {
+		once.Do(func() { close(done) })
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				fn()
+			case <-done:
+				return
+			}
+		}
+	}()
+	return stop
+}
+
+// replaceStop stores the stop function of a background task and stops the task it replaces.
+// Parameters:
+// - slot: The field holding the stop function, guarded by l.mu.
+// - stop: The new stop function, or nil to only stop the current task.
+func (l *Logger) replaceStop(slot *func(), stop func()) {
+	l.mu.Lock()
+	previous := *slot
+	*slot = stop
+	l.mu.Unlock()
+
+	if previous != nil {
+		previous()
+	}
+}
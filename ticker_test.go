@@ -0,0 +1,41 @@
+package Logger
+
+import (
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestStartTickerStops(t *testing.T) {
+	var calls atomic.Int32
+	stop := startTicker(time.Millisecond, func() { calls.Add(1) })
+	time.Sleep(20 * time.Millisecond)
+	stop()
+	stop()
+
+	stopped := calls.Load()
+	if stopped == 0 {
+		t.Fatal("fn was never called")
+	}
+	time.Sleep(20 * time.Millisecond)
+	if got := calls.Load(); got > stopped+1 {
+		t.Fatalf("fn called %d times after stop", got-stopped)
+	}
+}
+
+func TestHeartbeatSequence(t *testing.T) {
+	l, path := newTestLogger(t, INFO)
+	if stop := l.StartHeartbeat(0, "alive"); stop == nil {
+		t.Fatal("invalid interval returned a nil stop function")
+	}
+
+	stop := l.StartHeartbeat(5*time.Millisecond, "alive")
+	time.Sleep(30 * time.Millisecond)
+	stop()
+
+	got := readLog(t, path)
+	if !strings.Contains(got, "INFO: alive seq=1\n") || !strings.Contains(got, "INFO: alive seq=2\n") {
+		t.Fatalf("heartbeats missing:\n%s", got)
+	}
+}
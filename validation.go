@@ -0,0 +1,20 @@
+package Logger
+
+// ValidationError logs failed input validation at WARNING level, like a 4xx status in LevelForStatus.
+// The field errors are attached as a single "errors" attribute mapping each field to
+// its message, rendered as errors="{email=invalid, name=required}" sorted by field.
+// Parameters:
+// - msg: The log message, e.g. "invalid signup request".
+// - fieldErrors: The validation message for each invalid field.
+func (l *Logger) ValidationError(msg string, fieldErrors map[string]string) {
+	if !l.enabled(WARNING) {
+		return
+	}
+
+	// Copy the map so channel consumers never share it with the caller
+	errors := make(map[string]string, len(fieldErrors))
+	for field, message := range fieldErrors {
+		errors[field] = message
+	}
+	l.log(WARNING, msg, Attr{Key: "errors", Value: errors})
+}
@@ -0,0 +1,102 @@
+package Logger
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// minWrapWidth is the narrowest column the console text is wrapped to.
+// Narrower terminals leave lines unwrapped rather than wrapping every few characters.
+const minWrapWidth = 20
+
+// emojiColumns is the number of columns a level symbol is assumed to take up.
+// Most emoji are rendered two columns wide.
+const emojiColumns = 2
+
+// SetConsoleWrap wraps long console lines at the terminal width.
+// Wrapped lines are indented so they continue under the start of the message, after
+// the timestamp and level. The width is detected from the terminal on every line, so
+// resizing takes effect immediately; if stdout is not a terminal, lines are not wrapped
+// unless a width is set with SetConsoleWidth. The log file is never wrapped.
+// Parameters:
+// - wrap: Whether to wrap console lines.
+func (l *Logger) SetConsoleWrap(wrap bool) {
+	l.consoleWrap.Store(wrap)
+}
+
+// SetConsoleWidth sets a fixed width for SetConsoleWrap instead of the terminal width.
+// Parameters:
+// - width: The width in columns, or 0 to detect the terminal width.
+func (l *Logger) SetConsoleWidth(width int) {
+	if width < 0 {
+		width = 0
+	}
+	l.consoleWidth.Store(int64(width))
+}
+
+// wrapWidth returns the width console lines are wrapped at.
+// Returns:
+// - The fixed width, the terminal width, or 0 if it is unknown.
+func (l *Logger) wrapWidth() int {
+	if width := int(l.consoleWidth.Load()); width > 0 {
+		return width
+	}
+	return terminalWidth()
+}
+
+// wrapText wraps text at spaces so that no line exceeds the width, with a hanging indent.
+// Words longer than a line are broken. Widths are counted in runes.
+// Parameters:
+// - text: The text to wrap, starting at the indent column.
+// - width: The total width in columns.
+// - indent: The column the text starts at, used to indent continuation lines.
+// Returns:
+// - The wrapped text, or text itself if there is too little room to wrap.
+func wrapText(text string, width, indent int) string {
+	available := width - indent
+	if available < minWrapWidth || utf8.RuneCountInString(text) <= available {
+		return text
+	}
+
+	newline := "\n" + strings.Repeat(" ", indent)
+	var b strings.Builder
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			b.WriteString(newline)
+		}
+		column := 0
+		for j, word := range strings.Split(line, " ") {
+			length := utf8.RuneCountInString(word)
+			if j > 0 {
+				if column > 0 && column+1+length > available {
+					b.WriteString(newline)
+					column = 0
+				} else {
+					b.WriteByte(' ')
+					column++
+				}
+			}
+			if length <= available-column {
+				b.WriteString(word)
+				column += length
+				continue
+			}
+
+			// Break words that do not fit on a line of their own
+			runes := []rune(word)
+			for len(runes) > 0 {
+				room := available - column
+				if room <= 0 {
+					b.WriteString(newline)
+					column = 0
+					room = available
+				}
+				room = min(room, len(runes))
+				b.WriteString(string(runes[:room]))
+				column += room
+				runes = runes[room:]
+			}
+		}
+	}
+	return b.String()
+}
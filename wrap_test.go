@@ -0,0 +1,30 @@
+package Logger
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWrapText(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		width  int
+		indent int
+		want   string
+	}{
+		{"fits", "short text", 80, 10, "short text"},
+		{"too narrow to wrap", strings.Repeat("word ", 10), 25, 10, strings.Repeat("word ", 10)},
+		{"wraps at spaces", "the quick brown fox jumps over the lazy dog", 30, 5, "the quick brown fox jumps\n     over the lazy dog"},
+		{"keeps newlines with indent", "short\nthe quick brown fox jumps over", 30, 4, "short\n    the quick brown fox jumps\n    over"},
+		{"breaks long words", strings.Repeat("a", 45), 20, 0, strings.Repeat("a", 20) + "\n" + strings.Repeat("a", 20) + "\n" + strings.Repeat("a", 5)},
+		{"counts runes", strings.Repeat("ä", 25), 20, 0, strings.Repeat("ä", 20) + "\n" + strings.Repeat("ä", 5)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wrapText(tt.text, tt.width, tt.indent); got != tt.want {
+				t.Errorf("wrapText() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package Logger
+
+import (
+	"io"
+	"log"
+	"strings"
+)
+
+// levelWriter is an io.Writer that logs everything written to it at a fixed level.
+type levelWriter struct {
+	logger *Logger
+	level  LogLevel
+}
+
+// Write logs each line of p as a separate message.
+// Parameters:
+// - p: The bytes to log. Trailing newlines are removed.
+// Returns:
+// - The number of bytes consumed (always len(p)) and a nil error.
+func (w *levelWriter) Write(p []byte) (int, error) {
+	text := strings.TrimRight(string(p), "\r\n")
+	for _, line := range strings.Split(text, "\n") {
+		w.logger.log(w.level, strings.TrimRight(line, "\r"))
+	}
+	return len(p), nil
+}
+
+// Writer returns an io.Writer that logs every line written to it at the given level.
+// This is useful for connecting libraries that expect an io.Writer to the logger.
+// FATAL is logged as ERROR so that writing to the returned writer never exits the program.
+// Parameters:
+// - level: The log level used for the written lines.
+// Returns:
+// - An io.Writer backed by the logger.
+func (l *Logger) Writer(level LogLevel) io.Writer {
+	if level == FATAL {
+		level = ERROR
+	}
+	return &levelWriter{logger: l, level: level}
+}
+
+// CaptureStandardLog routes the standard library's default logger through this logger.
+// It sets the output of the "log" package to Writer(level) and clears its flags,
+// since the logger already adds its own timestamp.
+// Parameters:
+// - level: The log level used for messages from the standard logger.
+// Returns:
+// - A function that restores the previous output and flags of the standard logger.
+func (l *Logger) CaptureStandardLog(level LogLevel) (restore func()) {
+	previousOutput := log.Writer()
+	previousFlags := log.Flags()
+
+	log.SetOutput(l.Writer(level))
+	log.SetFlags(0)
+
+	return func() {
+		log.SetOutput(previousOutput)
+		log.SetFlags(previousFlags)
+	}
+}